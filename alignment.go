@@ -1,5 +1,7 @@
 package tablr
 
+import "fmt"
+
 // Alignment represents the alignment of a column in a Markdown table.
 type Alignment uint8
 
@@ -15,6 +17,22 @@ const (
 	AlignRight
 )
 
+// IsValid reports whether a is a known alignment.
 func (a Alignment) IsValid() bool {
 	return a >= AlignDefault && a <= AlignRight
 }
+
+// String returns the name of the alignment.
+func (a Alignment) String() string {
+	switch a {
+	case AlignDefault:
+		return "default"
+	case AlignLeft:
+		return "left"
+	case AlignCenter:
+		return "center"
+	case AlignRight:
+		return "right"
+	}
+	return fmt.Sprintf("Alignment(%d)", a)
+}
@@ -38,3 +38,22 @@ func TestAlignmentIsValid(t *testing.T) {
 		t.Error("Alignment(4) should not be valid")
 	}
 }
+
+func TestAlignmentString(t *testing.T) {
+	tests := []struct {
+		alignment tablr.Alignment
+		want      string
+	}{
+		{tablr.AlignDefault, "default"},
+		{tablr.AlignLeft, "left"},
+		{tablr.AlignCenter, "center"},
+		{tablr.AlignRight, "right"},
+		{tablr.Alignment(4), "Alignment(4)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.alignment.String(); got != tt.want {
+			t.Errorf("Alignment(%d).String() got = %q, want %q", tt.alignment, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package tablr
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// AsciiDocOption represents an option for configuring AsciiDoc output.
+type AsciiDocOption func(*asciiDocConfig)
+
+type asciiDocConfig struct {
+	header bool
+}
+
+// WithAsciiDocHeader sets whether the column headers are written as the
+// header row of the table. The header row is included by default.
+func WithAsciiDocHeader(header bool) AsciiDocOption {
+	return func(c *asciiDocConfig) {
+		c.header = header
+	}
+}
+
+// RenderAsciiDoc renders the table as an AsciiDoc table to the given writer.
+// The cols attribute is derived from the column alignments.
+func (t *Table) RenderAsciiDoc(w io.Writer, opts ...AsciiDocOption) error {
+	c := &asciiDocConfig{
+		header: true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+
+	specs := make([]string, len(t.columnAlignments))
+	for i, a := range t.columnAlignments {
+		specs[i] = asciiDocAlignment(a)
+	}
+	bw.WriteString(`[cols="` + strings.Join(specs, ",") + `"`)
+	if c.header {
+		bw.WriteString(`,options="header"`)
+	}
+	bw.WriteString("]\n|===\n")
+
+	if c.header {
+		writeAsciiDocRow(bw, t.columns)
+		if len(t.rows) > 0 {
+			bw.WriteString("\n")
+		}
+	}
+
+	for _, row := range t.rows {
+		writeAsciiDocRow(bw, row)
+	}
+
+	bw.WriteString("|===\n")
+
+	return bw.Flush()
+}
+
+// writeAsciiDocRow writes a row with all cells on a single line.
+func writeAsciiDocRow(w *bufio.Writer, row []string) {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = "|" + escapePipes(cell)
+	}
+	w.WriteString(strings.Join(cells, " "))
+	w.WriteString("\n")
+}
+
+// asciiDocAlignment returns the horizontal alignment operator for the
+// alignment.
+func asciiDocAlignment(a Alignment) string {
+	switch a {
+	case AlignCenter:
+		return "^"
+	case AlignRight:
+		return ">"
+	}
+	return "<"
+}
@@ -0,0 +1,86 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderAsciiDoc(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.AsciiDocOption
+		want    string
+	}{
+		{
+			name:    "Simple table",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `[cols="<,^,>",options="header"]
+|===
+|Name |Age |City
+
+|John Doe |30 |New York
+|Jane Smith |25 |Los Angeles
+|===
+`,
+		},
+		{
+			name:    "Pipes are escaped",
+			columns: []string{"Name | Lastname", "Age", "City"},
+			rows: [][]string{
+				{"John | Doe", "30", ""},
+			},
+			want: `[cols="<,^,>",options="header"]
+|===
+|Name \| Lastname |Age |City
+
+|John \| Doe |30 |
+|===
+`,
+		},
+		{
+			name:    "Without header",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.AsciiDocOption{tablr.WithAsciiDocHeader(false)},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			want: `[cols="<,^,>"]
+|===
+|John Doe |30 |New York
+|===
+`,
+		},
+		{
+			name:    "No rows",
+			columns: []string{"Name", "Age", "City"},
+			want: `[cols="<,^,>",options="header"]
+|===
+|Name |Age |City
+|===
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(defaultAlignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			if err := table.RenderAsciiDoc(&buf, tt.options...); err != nil {
+				t.Fatalf("RenderAsciiDoc() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderAsciiDoc() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package tablr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BoxStyle represents the border style used by RenderBox.
+type BoxStyle uint8
+
+const (
+	// BoxLight draws borders using light box-drawing characters.
+	BoxLight BoxStyle = iota
+	// BoxHeavy draws borders using heavy box-drawing characters.
+	BoxHeavy
+	// BoxDouble draws borders using double box-drawing characters.
+	BoxDouble
+	// BoxRounded draws borders using light box-drawing characters with
+	// rounded corners.
+	BoxRounded
+	// BoxASCII draws borders using +, - and | for terminals without Unicode
+	// support.
+	BoxASCII
+)
+
+// boxLine holds the characters of a horizontal border line.
+type boxLine struct {
+	left, fill, mid, right string
+}
+
+// boxGlyphs holds the characters used to draw a box style.
+type boxGlyphs struct {
+	vertical string
+	top      boxLine
+	header   boxLine
+	row      boxLine
+	bottom   boxLine
+}
+
+// boxStyles holds the characters of each box style. The header line is used
+// below the header when header emphasis is enabled.
+var boxStyles = map[BoxStyle]boxGlyphs{
+	BoxLight: {
+		vertical: "│",
+		top:      boxLine{"┌", "─", "┬", "┐"},
+		header:   boxLine{"╞", "═", "╪", "╡"},
+		row:      boxLine{"├", "─", "┼", "┤"},
+		bottom:   boxLine{"└", "─", "┴", "┘"},
+	},
+	BoxHeavy: {
+		vertical: "┃",
+		top:      boxLine{"┏", "━", "┳", "┓"},
+		header:   boxLine{"┣", "━", "╋", "┫"},
+		row:      boxLine{"┠", "─", "╂", "┨"},
+		bottom:   boxLine{"┗", "━", "┻", "┛"},
+	},
+	BoxDouble: {
+		vertical: "║",
+		top:      boxLine{"╔", "═", "╦", "╗"},
+		header:   boxLine{"╠", "═", "╬", "╣"},
+		row:      boxLine{"╟", "─", "╫", "╢"},
+		bottom:   boxLine{"╚", "═", "╩", "╝"},
+	},
+	BoxRounded: {
+		vertical: "│",
+		top:      boxLine{"╭", "─", "┬", "╮"},
+		header:   boxLine{"╞", "═", "╪", "╡"},
+		row:      boxLine{"├", "─", "┼", "┤"},
+		bottom:   boxLine{"╰", "─", "┴", "╯"},
+	},
+	BoxASCII: {
+		vertical: "|",
+		top:      boxLine{"+", "-", "+", "+"},
+		header:   boxLine{"+", "=", "+", "+"},
+		row:      boxLine{"+", "-", "+", "+"},
+		bottom:   boxLine{"+", "-", "+", "+"},
+	},
+}
+
+// BoxOption represents an option for configuring box-drawing output.
+type BoxOption func(*boxConfig)
+
+type boxConfig struct {
+	style          BoxStyle
+	rowSeparators  bool
+	padding        int
+	headerEmphasis bool
+}
+
+// WithBoxStyle sets the border style. The default is BoxLight.
+func WithBoxStyle(style BoxStyle) BoxOption {
+	return func(c *boxConfig) {
+		c.style = style
+	}
+}
+
+// WithBoxRowSeparators sets whether a line is drawn between data rows.
+func WithBoxRowSeparators(separators bool) BoxOption {
+	return func(c *boxConfig) {
+		c.rowSeparators = separators
+	}
+}
+
+// WithBoxPadding sets the number of spaces on either side of the cell content.
+// The default is 1.
+func WithBoxPadding(padding int) BoxOption {
+	return func(c *boxConfig) {
+		c.padding = padding
+	}
+}
+
+// WithBoxHeaderEmphasis sets whether the line below the header is drawn using
+// a heavier or double line than the other separators.
+func WithBoxHeaderEmphasis(emphasis bool) BoxOption {
+	return func(c *boxConfig) {
+		c.headerEmphasis = emphasis
+	}
+}
+
+// RenderBox renders the table with box-drawing borders to the given writer,
+// for display in a terminal. Columns are as wide as their widest cell, as
+// displayed in a terminal, or their minimum width if that is larger. Cells
+// containing line breaks span multiple lines.
+func (t *Table) RenderBox(w io.Writer, opts ...BoxOption) error {
+	c := &boxConfig{
+		padding: 1,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	glyphs, ok := boxStyles[c.style]
+	if !ok {
+		return fmt.Errorf("invalid box style: %d", c.style)
+	}
+	if c.padding < 0 {
+		return fmt.Errorf("invalid padding: %d", c.padding)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+	widths := t.columnDisplayWidths()
+	p := t.painter(w)
+
+	bw.WriteString(c.border(p, glyphs.top, widths))
+	c.writeRow(bw, p, glyphs, t.columns, widths, t.headerAlignments, func(int, string) Style {
+		return p.theme.Header
+	})
+
+	if len(t.rows) > 0 {
+		separator := glyphs.row
+		if c.headerEmphasis {
+			separator = glyphs.header
+		}
+		bw.WriteString(c.border(p, separator, widths))
+	}
+
+	for i, row := range t.rows {
+		if i > 0 && c.rowSeparators {
+			bw.WriteString(c.border(p, glyphs.row, widths))
+		}
+		c.writeRow(bw, p, glyphs, row, widths, t.columnAlignments, func(col int, value string) Style {
+			return p.cellStyle(t.columns, i, col, value)
+		})
+	}
+
+	bw.WriteString(c.border(p, glyphs.bottom, widths))
+
+	return bw.Flush()
+}
+
+// border returns a horizontal border line.
+func (c *boxConfig) border(p painter, line boxLine, widths []int) string {
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		parts[i] = strings.Repeat(line.fill, width+2*c.padding)
+	}
+	return p.paint(line.left+strings.Join(parts, line.mid)+line.right, p.theme.Border) + "\n"
+}
+
+// writeRow writes a row, which spans as many lines as the cell with the most
+// lines. The style of each cell, including its padding, is given by styleOf.
+func (c *boxConfig) writeRow(w *bufio.Writer, p painter, glyphs boxGlyphs, row []string, widths []int, alignments []Alignment, styleOf func(col int, value string) Style) {
+	lines := make([][]string, len(row))
+	styles := make([]Style, len(row))
+	height := 1
+	for i, cell := range row {
+		lines[i] = cellLines(cell)
+		styles[i] = styleOf(i, cell)
+		height = max(height, len(lines[i]))
+	}
+
+	padding := strings.Repeat(" ", c.padding)
+	vertical := p.paint(glyphs.vertical, p.theme.Border)
+	for line := range height {
+		w.WriteString(vertical)
+		for i := range row {
+			var text string
+			if line < len(lines[i]) {
+				text = lines[i][line]
+			}
+			w.WriteString(p.paint(padding+padDisplay(text, widths[i], alignments[i])+padding, styles[i]))
+			w.WriteString(vertical)
+		}
+		w.WriteString("\n")
+	}
+}
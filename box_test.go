@@ -0,0 +1,201 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderBox(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.BoxOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Light",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `┌────────────┬─────┬─────────────┐
+│ Name       │ Age │        City │
+├────────────┼─────┼─────────────┤
+│ John Doe   │ 30  │    New York │
+│ Jane Smith │ 25  │ Los Angeles │
+└────────────┴─────┴─────────────┘
+`,
+		},
+		{
+			name:    "Heavy with row separators",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			options: []tablr.BoxOption{
+				tablr.WithBoxStyle(tablr.BoxHeavy),
+				tablr.WithBoxRowSeparators(true),
+			},
+			want: `┏━━━━━━━━━━━━┳━━━━━┳━━━━━━━━━━━━━┓
+┃ Name       ┃ Age ┃        City ┃
+┠────────────╂─────╂─────────────┨
+┃ John Doe   ┃ 30  ┃    New York ┃
+┠────────────╂─────╂─────────────┨
+┃ Jane Smith ┃ 25  ┃ Los Angeles ┃
+┗━━━━━━━━━━━━┻━━━━━┻━━━━━━━━━━━━━┛
+`,
+		},
+		{
+			name:    "Double with header emphasis",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.BoxOption{
+				tablr.WithBoxStyle(tablr.BoxDouble),
+				tablr.WithBoxHeaderEmphasis(true),
+			},
+			want: `╔══════════╦═════╦══════════╗
+║ Name     ║ Age ║     City ║
+╠══════════╬═════╬══════════╣
+║ John Doe ║ 30  ║ New York ║
+╚══════════╩═════╩══════════╝
+`,
+		},
+		{
+			name:    "Rounded without padding",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.BoxOption{
+				tablr.WithBoxStyle(tablr.BoxRounded),
+				tablr.WithBoxPadding(0),
+			},
+			want: `╭────────┬───┬────────╮
+│Name    │Age│    City│
+├────────┼───┼────────┤
+│John Doe│30 │New York│
+╰────────┴───┴────────╯
+`,
+		},
+		{
+			name:    "ASCII with header emphasis",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.BoxOption{
+				tablr.WithBoxStyle(tablr.BoxASCII),
+				tablr.WithBoxHeaderEmphasis(true),
+			},
+			want: `+----------+-----+----------+
+| Name     | Age |     City |
++==========+=====+==========+
+| John Doe | 30  | New York |
++----------+-----+----------+
+`,
+		},
+		{
+			name:    "Multi-line cells and pipes",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John\nDoe", "30", "A|B"},
+			},
+			options: []tablr.BoxOption{tablr.WithBoxStyle(tablr.BoxASCII)},
+			want: `+------+-----+------+
+| Name | Age | City |
++------+-----+------+
+| John | 30  |  A|B |
+| Doe  |     |      |
++------+-----+------+
+`,
+		},
+		{
+			name:    "Non-ASCII and wide characters",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"café", "30", "東京"},
+				{"cafe", "25", "Oslo"},
+			},
+			want: `┌──────┬─────┬──────┐
+│ Name │ Age │ City │
+├──────┼─────┼──────┤
+│ café │ 30  │ 東京 │
+│ cafe │ 25  │ Oslo │
+└──────┴─────┴──────┘
+`,
+		},
+		{
+			name:    "No rows",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.BoxOption{tablr.WithBoxStyle(tablr.BoxASCII)},
+			want: `+------+-----+------+
+| Name | Age | City |
++------+-----+------+
+`,
+		},
+		{
+			name:    "Invalid style",
+			columns: []string{"Name"},
+			options: []tablr.BoxOption{tablr.WithBoxStyle(tablr.BoxStyle(99))},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid padding",
+			columns: []string{"Name"},
+			options: []tablr.BoxOption{tablr.WithBoxPadding(-1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(defaultAlignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderBox(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderBox() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderBox() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderBox_MinColumnWidth(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "City"})
+	table.AddRows([][]string{
+		{"café", "東京"},
+	})
+	if err := table.SetColumnMinWidth(0, 8); err != nil {
+		t.Fatalf("SetColumnMinWidth() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := table.RenderBox(&buf); err != nil {
+		t.Fatalf("RenderBox() error = %v", err)
+	}
+
+	want := `┌──────────┬──────┐
+│ Name     │ City │
+├──────────┼──────┤
+│ café     │ 東京 │
+└──────────┴──────┘
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderBox() got = \n%v, want \n%v", got, want)
+	}
+}
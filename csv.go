@@ -0,0 +1,111 @@
+package tablr
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// CSVOption represents an option for configuring CSV output.
+type CSVOption func(*csvConfig)
+
+type csvConfig struct {
+	delimiter      rune
+	useCRLF        bool
+	header         bool
+	escapeFormulas bool
+}
+
+// WithCSVDelimiter sets the field delimiter. The default is a comma.
+func WithCSVDelimiter(delimiter rune) CSVOption {
+	return func(c *csvConfig) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithCSVCRLF sets whether lines are terminated with \r\n instead of \n.
+func WithCSVCRLF(useCRLF bool) CSVOption {
+	return func(c *csvConfig) {
+		c.useCRLF = useCRLF
+	}
+}
+
+// WithCSVHeader sets whether the column headers are written as the first
+// record. Headers are included by default.
+func WithCSVHeader(header bool) CSVOption {
+	return func(c *csvConfig) {
+		c.header = header
+	}
+}
+
+// WithCSVFormulaEscaping sets whether cells that a spreadsheet application
+// would interpret as a formula are prefixed with a single quote. Enable this
+// when the table contains user-supplied values.
+func WithCSVFormulaEscaping(escape bool) CSVOption {
+	return func(c *csvConfig) {
+		c.escapeFormulas = escape
+	}
+}
+
+// RenderCSV renders the table as CSV to the given writer.
+func (t *Table) RenderCSV(w io.Writer, opts ...CSVOption) error {
+	c := &csvConfig{
+		delimiter: ',',
+		header:    true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	cw := csv.NewWriter(w)
+	cw.Comma = c.delimiter
+	cw.UseCRLF = c.useCRLF
+
+	if c.header {
+		if err := cw.Write(c.record(t.columns)); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range t.rows {
+		if err := cw.Write(c.record(row)); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// RenderTSV renders the table as tab separated values to the given writer.
+func (t *Table) RenderTSV(w io.Writer, opts ...CSVOption) error {
+	return t.RenderCSV(w, append([]CSVOption{WithCSVDelimiter('\t')}, opts...)...)
+}
+
+// record returns the values to write for a single CSV record.
+func (c *csvConfig) record(values []string) []string {
+	if !c.escapeFormulas {
+		return values
+	}
+
+	record := make([]string, len(values))
+	for i, val := range values {
+		record[i] = escapeFormula(val)
+	}
+
+	return record
+}
+
+// escapeFormula prefixes s with a single quote if it starts with a character
+// that spreadsheet applications treat as the start of a formula.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,117 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.CSVOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Simple table",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: "Name,Age,City\nJohn Doe,30,New York\nJane Smith,25,Los Angeles\n",
+		},
+		{
+			name:    "Raw values are used",
+			columns: []string{"Name | Lastname", "Quote"},
+			rows: [][]string{
+				{"John | Doe", `say "hi", then leave`},
+			},
+			want: "Name | Lastname,Quote\nJohn | Doe,\"say \"\"hi\"\", then leave\"\n",
+		},
+		{
+			name:    "Without header",
+			columns: []string{"Name", "Age"},
+			options: []tablr.CSVOption{tablr.WithCSVHeader(false)},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			want: "John Doe,30\n",
+		},
+		{
+			name:    "Custom delimiter and CRLF",
+			columns: []string{"Name", "Age"},
+			options: []tablr.CSVOption{tablr.WithCSVDelimiter(';'), tablr.WithCSVCRLF(true)},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			want: "Name;Age\r\nJohn Doe;30\r\n",
+		},
+		{
+			name:    "Formulas are kept by default",
+			columns: []string{"Value"},
+			rows: [][]string{
+				{"=1+2"},
+			},
+			want: "Value\n=1+2\n",
+		},
+		{
+			name:    "Formula escaping",
+			columns: []string{"Value"},
+			options: []tablr.CSVOption{tablr.WithCSVFormulaEscaping(true)},
+			rows: [][]string{
+				{"=HYPERLINK(\"http://example.com\")"},
+				{"+1"},
+				{"-1"},
+				{"@SUM(A1)"},
+				{"safe"},
+				{""},
+			},
+			want: "Value\n\"'=HYPERLINK(\"\"http://example.com\"\")\"\n'+1\n'-1\n'@SUM(A1)\nsafe\n\n",
+		},
+		{
+			name:    "Invalid delimiter",
+			columns: []string{"Name"},
+			options: []tablr.CSVOption{tablr.WithCSVDelimiter('"')},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns)
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderCSV(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderCSV() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderTSV(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age"})
+	table.AddRow([]string{"John Doe", "30"})
+
+	var buf bytes.Buffer
+	if err := table.RenderTSV(&buf, tablr.WithCSVHeader(false)); err != nil {
+		t.Fatalf("RenderTSV() error = %v", err)
+	}
+
+	want := "John Doe\t30\n"
+	if got := buf.String(); got != want {
+		t.Errorf("RenderTSV() got = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+package tablr
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// fixedWidthTabStop is the tab stop used when expanding tabs in fixed-width
+// text.
+const fixedWidthTabStop = 8
+
+// FromFixedWidth creates a new table from text laid out in fixed-width
+// columns, such as the output of ps, docker ps or kubectl get. The first
+// non-blank line holds the headers, and blank lines are skipped.
+//
+// Unless set using WithColumnBoundaries, a column starts at each header word
+// that is preceded by whitespace on every line, so headers containing spaces,
+// such as "CONTAINER ID", stay a single column, while values that are
+// right-aligned past the start of their header are kept whole. Columns
+// holding only numbers are right-aligned.
+func FromFixedWidth(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	var lines [][]rune
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(expandTabs(scanner.Text()), " \r")
+		if line != "" {
+			lines = append(lines, []rune(line))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("no header found")
+	}
+
+	starts := c.boundaries
+	if starts == nil {
+		starts = fixedWidthBoundaries(lines)
+	}
+	if !slices.IsSorted(starts) || (len(starts) > 0 && starts[0] < 0) {
+		return nil, fmt.Errorf("invalid column boundaries: %v", starts)
+	}
+
+	split := func(line []rune) []string {
+		cells := make([]string, len(starts))
+		for i, start := range starts {
+			end := len(line)
+			if i+1 < len(starts) {
+				end = min(end, starts[i+1])
+			}
+			if start < end {
+				cells[i] = strings.TrimSpace(string(line[start:end]))
+			}
+		}
+		return cells
+	}
+
+	columns := split(lines[0])
+	rows := make([][]string, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		rows = append(rows, split(line))
+	}
+
+	alignments := make([]Alignment, len(columns))
+	for i := range columns {
+		numeric := false
+		for _, row := range rows {
+			if row[i] == "" {
+				continue
+			}
+			if numeric = isNumber(row[i]); !numeric {
+				break
+			}
+		}
+		if numeric {
+			alignments[i] = AlignRight
+		}
+	}
+
+	return c.newTable(columns, rows, alignments), nil
+}
+
+// fixedWidthBoundaries returns the offsets at which columns start, inferred
+// from the words of the header line, lines[0], and the whitespace gutters
+// running through all lines.
+func fixedWidthBoundaries(lines [][]rune) []int {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+
+	gutter := make([]bool, width)
+	for p := range gutter {
+		gutter[p] = true
+		for _, line := range lines {
+			if p < len(line) && line[p] != ' ' {
+				gutter[p] = false
+				break
+			}
+		}
+	}
+
+	// The first column starts at the beginning of the line, even if its
+	// header is indented, e.g. because it is right-aligned.
+	header := lines[0]
+	starts := []int{0}
+	for p := 1; p < len(header); p++ {
+		if header[p] == ' ' || header[p-1] != ' ' || strings.TrimSpace(string(header[:p])) == "" {
+			continue
+		}
+		// Find the gutter closest to the start of the header word, between it
+		// and the end of the previous header word.
+		for q := p - 1; q >= 0 && header[q] == ' '; q-- {
+			if gutter[q] {
+				starts = append(starts, q+1)
+				break
+			}
+		}
+	}
+
+	return starts
+}
+
+// expandTabs replaces the tabs in s with spaces up to the next tab stop.
+func expandTabs(s string) string {
+	if !strings.Contains(s, "\t") {
+		return s
+	}
+
+	var sb strings.Builder
+	n := 0
+	for _, r := range s {
+		if r == '\t' {
+			spaces := fixedWidthTabStop - n%fixedWidthTabStop
+			sb.WriteString(strings.Repeat(" ", spaces))
+			n += spaces
+			continue
+		}
+		sb.WriteRune(r)
+		n++
+	}
+	return sb.String()
+}
@@ -0,0 +1,121 @@
+package tablr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestFromFixedWidth(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		options     []tablr.ImportOption
+		wantColumns []string
+		wantRows    [][]string
+		wantAligns  []tablr.Alignment
+		wantErr     bool
+	}{
+		{
+			name: "docker ps",
+			input: `CONTAINER ID   IMAGE          COMMAND                  CREATED        STATUS        PORTS                  NAMES
+a1b2c3d4e5f6   nginx:latest   "/docker-entrypoint.…"   2 hours ago    Up 2 hours    0.0.0.0:8080->80/tcp   web
+f6e5d4c3b2a1   redis:7        "docker-entrypoint.s…"   3 days ago     Up 3 days                            cache
+`,
+			wantColumns: []string{"CONTAINER ID", "IMAGE", "COMMAND", "CREATED", "STATUS", "PORTS", "NAMES"},
+			wantRows: [][]string{
+				{"a1b2c3d4e5f6", "nginx:latest", `"/docker-entrypoint.…"`, "2 hours ago", "Up 2 hours", "0.0.0.0:8080->80/tcp", "web"},
+				{"f6e5d4c3b2a1", "redis:7", `"docker-entrypoint.s…"`, "3 days ago", "Up 3 days", "", "cache"},
+			},
+			wantAligns: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault,
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault,
+			},
+		},
+		{
+			name: "ps with right-aligned numbers",
+			input: `    PID TTY          TIME CMD
+      1 pts/0    00:00:00 bash
+  12345 pts/0    00:00:03 ps aux
+`,
+			wantColumns: []string{"PID", "TTY", "TIME", "CMD"},
+			wantRows: [][]string{
+				{"1", "pts/0", "00:00:00", "bash"},
+				{"12345", "pts/0", "00:00:03", "ps aux"},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignRight, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name: "kubectl with values wider than headers",
+			input: `
+NAME                     READY   STATUS    RESTARTS      AGE
+web-7d4b9c8f5-abcde      1/1     Running   0             3d
+worker-5f6d7c8b9-fghij   0/1     Error     1234567890    12m
+
+`,
+			wantColumns: []string{"NAME", "READY", "STATUS", "RESTARTS", "AGE"},
+			wantRows: [][]string{
+				{"web-7d4b9c8f5-abcde", "1/1", "Running", "0", "3d"},
+				{"worker-5f6d7c8b9-fghij", "0/1", "Error", "1234567890", "12m"},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight, tablr.AlignDefault},
+		},
+		{
+			name:        "Tabs",
+			input:       "NAME\tSIZE\nfoo\t12\nbarbaz\t3\n",
+			wantColumns: []string{"NAME", "SIZE"},
+			wantRows: [][]string{
+				{"foo", "12"},
+				{"barbaz", "3"},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignDefault, tablr.AlignRight},
+		},
+		{
+			name: "Explicit boundaries",
+			input: `ID NAME  CITY
+1  Ann   Oslo
+22 Bo B  Rome
+`,
+			options:     []tablr.ImportOption{tablr.WithColumnBoundaries(0, 3, 9)},
+			wantColumns: []string{"ID", "NAME", "CITY"},
+			wantRows: [][]string{
+				{"1", "Ann", "Oslo"},
+				{"22", "Bo B", "Rome"},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignRight, tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name:    "Invalid boundaries",
+			input:   "ID NAME\n1  Ann\n",
+			options: []tablr.ImportOption{tablr.WithColumnBoundaries(3, 0)},
+			wantErr: true,
+		},
+		{
+			name:    "Empty input",
+			input:   "\n\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromFixedWidth(strings.NewReader(tt.input), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromFixedWidth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromFixedWidth() columns = %q, want %q", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromFixedWidth() rows = %q, want %q", got, tt.wantRows)
+			}
+			if got := table.GetAlignments(); !equalSlices(got, tt.wantAligns) {
+				t.Errorf("FromFixedWidth() alignments = %v, want %v", got, tt.wantAligns)
+			}
+		})
+	}
+}
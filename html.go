@@ -0,0 +1,513 @@
+package tablr
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxHTMLSpan is the largest colspan or rowspan honored, which protects
+// against documents that would otherwise expand to huge tables.
+const maxHTMLSpan = 1000
+
+// htmlRawTextElements holds the elements whose content is not markup and is
+// skipped.
+var htmlRawTextElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"textarea": true,
+	"title":    true,
+}
+
+// htmlBreakElements holds the elements that separate words, even without
+// whitespace around them.
+var htmlBreakElements = map[string]bool{
+	"br":         true,
+	"p":          true,
+	"div":        true,
+	"li":         true,
+	"ul":         true,
+	"ol":         true,
+	"h1":         true,
+	"h2":         true,
+	"h3":         true,
+	"h4":         true,
+	"h5":         true,
+	"h6":         true,
+	"hr":         true,
+	"pre":        true,
+	"blockquote": true,
+}
+
+// FromHTML creates tables from the <table> elements of an HTML document, in
+// the order in which they start. Nested tables become tables of their own.
+// A single table can be selected using WithTableIndex or WithTableID.
+//
+// The first row of each table holds the headers. The text of the cells has
+// its whitespace collapsed. Cells spanning multiple columns or rows are
+// expanded, leaving the covered cells empty unless WithRepeatedSpans is used.
+// Column alignments are taken from the align attribute or the text-align
+// style of the cells, and the <caption> becomes the caption of the table.
+func FromHTML(r io.Reader, opts ...ImportOption) ([]*Table, error) {
+	c := newImportConfig(opts)
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var all, stack []*htmlTable
+	z := &htmlTokenizer{s: string(data)}
+	for {
+		tok, ok := z.next()
+		if !ok {
+			break
+		}
+
+		if tok.kind == htmlStartTag && tok.name == "table" {
+			t := &htmlTable{id: tok.attrs["id"]}
+			all = append(all, t)
+			stack = append(stack, t)
+			continue
+		}
+		if len(stack) == 0 {
+			continue
+		}
+
+		top := stack[len(stack)-1]
+		if tok.kind == htmlEndTag && tok.name == "table" {
+			top.endRow()
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		top.handle(tok)
+	}
+	for _, t := range stack {
+		t.endRow()
+	}
+
+	switch {
+	case c.tableID != "":
+		i := slices.IndexFunc(all, func(t *htmlTable) bool {
+			return t.id == c.tableID
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("table with id %q not found", c.tableID)
+		}
+		all = all[i : i+1]
+	case c.tableIndex >= 0:
+		if c.tableIndex >= len(all) {
+			return nil, fmt.Errorf("table index %d out of range", c.tableIndex)
+		}
+		all = all[c.tableIndex : c.tableIndex+1]
+	}
+
+	tables := make([]*Table, len(all))
+	for i, t := range all {
+		tables[i] = t.table(c)
+	}
+
+	return tables, nil
+}
+
+// htmlCell holds a cell of an HTML table.
+type htmlCell struct {
+	text    string
+	align   Alignment
+	colspan int
+	rowspan int
+}
+
+// htmlTable collects the rows of an HTML table as its tokens are handled.
+type htmlTable struct {
+	id       string
+	caption  strings.Builder
+	rows     [][]htmlCell
+	row      []htmlCell
+	rowAlign Alignment
+	inRow    bool
+	cell     *htmlCell
+	text     strings.Builder
+	section  string
+}
+
+// handle updates the table with a token found within it.
+func (t *htmlTable) handle(tok htmlToken) {
+	switch tok.kind {
+	case htmlText:
+		switch {
+		case t.cell != nil:
+			t.text.WriteString(tok.text)
+		case t.section == "caption":
+			t.caption.WriteString(tok.text)
+		}
+		return
+	case htmlEndTag:
+		switch tok.name {
+		case "td", "th":
+			t.endCell()
+		case "tr", "thead", "tbody", "tfoot":
+			t.endRow()
+		case "caption":
+			t.section = ""
+		}
+	case htmlStartTag:
+		switch tok.name {
+		case "td", "th":
+			t.endCell()
+			t.inRow = true
+			t.cell = &htmlCell{
+				align:   htmlAlignment(tok.attrs, t.rowAlign),
+				colspan: htmlSpan(tok.attrs["colspan"]),
+				rowspan: htmlSpan(tok.attrs["rowspan"]),
+			}
+		case "tr":
+			t.endRow()
+			t.inRow = true
+			t.rowAlign = htmlAlignment(tok.attrs, AlignDefault)
+		case "thead", "tbody", "tfoot":
+			t.endRow()
+		case "caption":
+			t.endRow()
+			t.section = "caption"
+		}
+	}
+
+	if htmlBreakElements[tok.name] && t.cell != nil {
+		t.text.WriteString(" ")
+	}
+}
+
+// endCell adds the current cell, if any, to the current row.
+func (t *htmlTable) endCell() {
+	if t.cell == nil {
+		return
+	}
+	t.cell.text = collapseHTMLSpace(t.text.String())
+	t.row = append(t.row, *t.cell)
+	t.cell = nil
+	t.text.Reset()
+}
+
+// endRow adds the current row, if any, to the table.
+func (t *htmlTable) endRow() {
+	t.endCell()
+	if t.inRow && len(t.row) > 0 {
+		t.rows = append(t.rows, t.row)
+	}
+	t.row = nil
+	t.inRow = false
+	t.rowAlign = AlignDefault
+}
+
+// table creates the table, expanding the cells spanning multiple columns or
+// rows.
+func (t *htmlTable) table(c *importConfig) *Table {
+	// below holds, by column, the cell spanning down into the next rows and
+	// the number of rows it still covers.
+	type cover struct {
+		cell      htmlCell
+		remaining int
+	}
+	var below []cover
+
+	var grid [][]htmlCell
+	for _, row := range t.rows {
+		var out []htmlCell
+		covered := func() bool {
+			return len(out) < len(below) && below[len(out)].remaining > 0
+		}
+		takeCovered := func() {
+			below[len(out)].remaining--
+			out = append(out, below[len(out)].cell)
+		}
+
+		for _, cell := range row {
+			for covered() {
+				takeCovered()
+			}
+			for k := range cell.colspan {
+				spanned := cell
+				if !c.repeatSpans {
+					spanned.text = ""
+				}
+				if k == 0 {
+					out = append(out, cell)
+				} else {
+					out = append(out, spanned)
+				}
+				if len(below) < len(out) {
+					below = append(below, make([]cover, len(out)-len(below))...)
+				}
+				below[len(out)-1] = cover{cell: spanned, remaining: cell.rowspan - 1}
+			}
+		}
+		for len(out) < len(below) {
+			if covered() {
+				takeCovered()
+			} else {
+				out = append(out, htmlCell{})
+			}
+		}
+		grid = append(grid, out)
+	}
+
+	width := 0
+	for _, row := range grid {
+		width = max(width, len(row))
+	}
+
+	// The alignment of a column is that of its last aligned cell, so the
+	// alignment of the header only counts if no data cells are aligned.
+	var columns []string
+	var rows [][]string
+	alignments := make([]Alignment, width)
+	for r, row := range grid {
+		cells := make([]string, width)
+		for i, cell := range row {
+			cells[i] = cell.text
+			if cell.align != AlignDefault {
+				alignments[i] = cell.align
+			}
+		}
+		if r == 0 {
+			columns = cells
+			continue
+		}
+		rows = append(rows, cells)
+	}
+	if columns == nil {
+		columns = []string{}
+	}
+
+	table := c.newTable(columns, rows, alignments)
+	table.SetCaption(collapseHTMLSpace(t.caption.String()), "")
+
+	return table
+}
+
+// htmlAlignment returns the alignment given by the align attribute or the
+// text-align style, or def if there is none.
+func htmlAlignment(attrs map[string]string, def Alignment) Alignment {
+	value := attrs["align"]
+	for _, decl := range strings.Split(attrs["style"], ";") {
+		if name, v, ok := strings.Cut(decl, ":"); ok && strings.EqualFold(strings.TrimSpace(name), "text-align") {
+			value = v
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "left", "start":
+		return AlignLeft
+	case "center":
+		return AlignCenter
+	case "right", "end":
+		return AlignRight
+	}
+	return def
+}
+
+// htmlSpan returns the number of columns or rows given by a colspan or
+// rowspan attribute.
+func htmlSpan(value string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n < 1 {
+		return 1
+	}
+	return min(n, maxHTMLSpan)
+}
+
+// htmlTokenKind represents the kind of an HTML token.
+type htmlTokenKind uint8
+
+const (
+	htmlText htmlTokenKind = iota
+	htmlStartTag
+	htmlEndTag
+)
+
+// htmlToken represents a piece of text or a tag of an HTML document. Names
+// are in lower case, and text and attribute values have their character
+// references decoded.
+type htmlToken struct {
+	kind  htmlTokenKind
+	name  string
+	attrs map[string]string
+	text  string
+}
+
+// htmlTokenizer splits an HTML document into tokens. Comments, doctypes,
+// processing instructions and the content of raw text elements, such as
+// <script>, are skipped.
+type htmlTokenizer struct {
+	s string
+	i int
+}
+
+// next returns the next token, or false at the end of the document.
+func (z *htmlTokenizer) next() (htmlToken, bool) {
+	for z.i < len(z.s) {
+		rest := z.s[z.i:]
+		if rest[0] != '<' || len(rest) == 1 {
+			end := strings.IndexByte(rest[1:], '<') + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			z.i += end
+			return htmlToken{kind: htmlText, text: html.UnescapeString(rest[:end])}, true
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			z.skipPast(4, "-->")
+		case rest[1] == '!' || rest[1] == '?':
+			z.skipPast(2, ">")
+		case rest[1] == '/' && len(rest) > 2 && isASCIILetter(rest[2]):
+			z.i += 2
+			name := z.name()
+			z.skipPast(0, ">")
+			return htmlToken{kind: htmlEndTag, name: name}, true
+		case isASCIILetter(rest[1]):
+			z.i++
+			tok := z.startTag()
+			if htmlRawTextElements[tok.name] {
+				z.skipRawText(tok.name)
+			}
+			return tok, true
+		default:
+			z.i++
+			return htmlToken{kind: htmlText, text: "<"}, true
+		}
+	}
+
+	return htmlToken{}, false
+}
+
+// startTag reads a start tag, after its opening <.
+func (z *htmlTokenizer) startTag() htmlToken {
+	tok := htmlToken{kind: htmlStartTag, name: z.name(), attrs: make(map[string]string)}
+
+	for z.i < len(z.s) {
+		z.skipSpace()
+		if z.i >= len(z.s) {
+			break
+		}
+		switch z.s[z.i] {
+		case '>':
+			z.i++
+			return tok
+		case '/':
+			z.i++
+			continue
+		}
+
+		start := z.i
+		for z.i < len(z.s) && !isHTMLSpace(z.s[z.i]) && !strings.ContainsRune("=>/", rune(z.s[z.i])) {
+			z.i++
+		}
+		name := strings.ToLower(z.s[start:z.i])
+		if name == "" {
+			// A stray = or similar; skip it.
+			z.i++
+			continue
+		}
+
+		z.skipSpace()
+		var value string
+		if z.i < len(z.s) && z.s[z.i] == '=' {
+			z.i++
+			z.skipSpace()
+			value = z.attrValue()
+		}
+		if _, ok := tok.attrs[name]; !ok {
+			tok.attrs[name] = html.UnescapeString(value)
+		}
+	}
+
+	return tok
+}
+
+// attrValue reads a quoted or unquoted attribute value.
+func (z *htmlTokenizer) attrValue() string {
+	if z.i >= len(z.s) {
+		return ""
+	}
+
+	if q := z.s[z.i]; q == '"' || q == '\'' {
+		end := strings.IndexByte(z.s[z.i+1:], q)
+		if end < 0 {
+			value := z.s[z.i+1:]
+			z.i = len(z.s)
+			return value
+		}
+		value := z.s[z.i+1 : z.i+1+end]
+		z.i += end + 2
+		return value
+	}
+
+	start := z.i
+	for z.i < len(z.s) && !isHTMLSpace(z.s[z.i]) && z.s[z.i] != '>' {
+		z.i++
+	}
+	return z.s[start:z.i]
+}
+
+// name reads a tag name and returns it in lower case.
+func (z *htmlTokenizer) name() string {
+	start := z.i
+	for z.i < len(z.s) && !isHTMLSpace(z.s[z.i]) && z.s[z.i] != '/' && z.s[z.i] != '>' {
+		z.i++
+	}
+	return strings.ToLower(z.s[start:z.i])
+}
+
+// skipRawText skips the content of a raw text element, up to its end tag.
+func (z *htmlTokenizer) skipRawText(name string) {
+	end := strings.Index(strings.ToLower(z.s[z.i:]), "</"+name)
+	if end < 0 {
+		z.i = len(z.s)
+		return
+	}
+	z.i += end
+}
+
+// skipPast skips offset bytes and then everything up to and including the
+// next occurrence of marker, or to the end of the document.
+func (z *htmlTokenizer) skipPast(offset int, marker string) {
+	z.i = min(z.i+offset, len(z.s))
+	end := strings.Index(z.s[z.i:], marker)
+	if end < 0 {
+		z.i = len(z.s)
+		return
+	}
+	z.i += end + len(marker)
+}
+
+// skipSpace skips whitespace.
+func (z *htmlTokenizer) skipSpace() {
+	for z.i < len(z.s) && isHTMLSpace(z.s[z.i]) {
+		z.i++
+	}
+}
+
+// collapseHTMLSpace trims s and replaces each run of whitespace within it by
+// a single space. Non-breaking spaces are kept, as browsers do.
+func collapseHTMLSpace(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return r < utf8.RuneSelf && isHTMLSpace(byte(r))
+	}), " ")
+}
+
+// isHTMLSpace reports whether b is whitespace in HTML.
+func isHTMLSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
+
+// isASCIILetter reports whether b is an ASCII letter.
+func isASCIILetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,165 @@
+package tablr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestFromHTML(t *testing.T) {
+	document := `<!DOCTYPE html>
+<html>
+<head><title>Report <table></title>
+<script>var s = "<table><tr><td>no</td></tr></table>";</script>
+</head>
+<body>
+<!-- <table><tr><td>commented</td></tr></table> -->
+<table id="hosts" class=report>
+  <caption>  Host
+    status </caption>
+  <thead>
+    <tr><th>Host</th><th align="center">State</th><th style="color: red; TEXT-ALIGN: right">Load</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>web&nbsp;1</td><td>up</td><td>0.5</td></tr>
+    <tr><td>  db
+      1 </td><td>down &amp; out</td><td>1.25</td></tr>
+    <tr><td>cache<br>1</td><td>up
+  </tbody>
+</table>
+<TABLE id='spans'>
+  <TR><TH colspan=2>Name</TH><TH>Zone</TH></TR>
+  <TR><TD>first</TD><TD>last</TD><TD rowspan="2" align="right">eu</TD></TR>
+  <TR><TD colspan="2">both</TD></TR>
+  <TR><TD rowspan="0">a</TD><TD rowspan="x">b</TD><TD>c</TD></TR>
+</TABLE>
+<table>
+  <tr><th>Outer</th></tr>
+  <tr><td><table><tr><th>Inner</th></tr><tr><td>x &lt; y</td></tr></table> after</td></tr>
+</table>
+</body>
+</html>`
+
+	tests := []struct {
+		name    string
+		options []tablr.ImportOption
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "All tables",
+			want: []string{
+				"Host status\n" +
+					"[Host State Load]\n" +
+					"[web 1 up 0.5]\n" +
+					"[db 1 down & out 1.25]\n" +
+					"[cache 1 up ]\n" +
+					"[default center right]",
+				"\n" +
+					"[Name  Zone]\n" +
+					"[first last eu]\n" +
+					"[both  ]\n" +
+					"[a b c]\n" +
+					"[default default right]",
+				"\n" +
+					"[Outer]\n" +
+					"[after]\n" +
+					"[default]",
+				"\n" +
+					"[Inner]\n" +
+					"[x < y]\n" +
+					"[default]",
+			},
+		},
+		{
+			name:    "Select by index",
+			options: []tablr.ImportOption{tablr.WithTableIndex(3)},
+			want: []string{
+				"\n" +
+					"[Inner]\n" +
+					"[x < y]\n" +
+					"[default]",
+			},
+		},
+		{
+			name:    "Select by id with repeated spans",
+			options: []tablr.ImportOption{tablr.WithTableID("spans"), tablr.WithRepeatedSpans()},
+			want: []string{
+				"\n" +
+					"[Name Name Zone]\n" +
+					"[first last eu]\n" +
+					"[both both eu]\n" +
+					"[a b c]\n" +
+					"[default default right]",
+			},
+		},
+		{
+			name:    "Select by id with allowed columns",
+			options: []tablr.ImportOption{tablr.WithTableID("hosts"), tablr.WithAllowedColumns("Host", "Load")},
+			want: []string{
+				"Host status\n" +
+					"[Host Load]\n" +
+					"[web 1 0.5]\n" +
+					"[db 1 1.25]\n" +
+					"[cache 1 ]\n" +
+					"[default right]",
+			},
+		},
+		{
+			name:    "Unknown id",
+			options: []tablr.ImportOption{tablr.WithTableID("missing")},
+			wantErr: true,
+		},
+		{
+			name:    "Index out of range",
+			options: []tablr.ImportOption{tablr.WithTableIndex(4)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := tablr.FromHTML(strings.NewReader(document), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromHTML() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := make([]string, len(tables))
+			for i, table := range tables {
+				caption, _ := table.GetCaption()
+				lines := []string{caption, formatCells(table.GetColumns())}
+				for _, row := range table.GetRows() {
+					lines = append(lines, formatCells(row))
+				}
+				var alignments []string
+				for _, a := range table.GetAlignments() {
+					alignments = append(alignments, a.String())
+				}
+				lines = append(lines, formatCells(alignments))
+				got[i] = strings.Join(lines, "\n")
+			}
+			if !equalSlices(got, tt.want) {
+				t.Errorf("FromHTML() got = \n%q, want \n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromHTML_NoTables(t *testing.T) {
+	tables, err := tablr.FromHTML(strings.NewReader("<p>Nothing < here</p>"))
+	if err != nil {
+		t.Fatalf("FromHTML() error = %v", err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("FromHTML() got %d tables, want none", len(tables))
+	}
+}
+
+// formatCells formats cells separated by single spaces, in brackets.
+func formatCells(cells []string) string {
+	return "[" + strings.Join(cells, " ") + "]"
+}
@@ -0,0 +1,314 @@
+package tablr
+
+import (
+	"io"
+	"os"
+	"slices"
+	"time"
+)
+
+// ImportOption represents an option for configuring how a table is created by
+// one of the From* functions. Options that only apply to some of them name
+// those functions and are ignored by the others.
+type ImportOption func(*importConfig)
+
+type importConfig struct {
+	writer          io.Writer
+	tableOptions    []TableOption
+	sortColumns     bool
+	arraySeparator  string
+	allowedColumns  map[string]bool
+	rowLimit        int
+	nullPlaceholder string
+	timeFormat      string
+	sheetName       string
+	sheetIndex      int
+	cellRange       string
+	headerRow       int
+	boundaries      []int
+	preferred       []string
+	tableIndex      int
+	tableID         string
+	repeatSpans     bool
+	metricPrefixes  []string
+	pivotLabel      string
+}
+
+// newImportConfig returns an importConfig with the defaults applied, followed
+// by the given options.
+func newImportConfig(opts []ImportOption) *importConfig {
+	c := &importConfig{
+		writer:          os.Stdout,
+		arraySeparator:  ", ",
+		nullPlaceholder: "NULL",
+		timeFormat:      time.RFC3339,
+		tableIndex:      -1,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// WithWriter sets the writer of the created table. The default is os.Stdout.
+func WithWriter(writer io.Writer) ImportOption {
+	return func(c *importConfig) {
+		c.writer = writer
+	}
+}
+
+// WithTableOptions sets the options used when creating the table.
+func WithTableOptions(opts ...TableOption) ImportOption {
+	return func(c *importConfig) {
+		c.tableOptions = append(c.tableOptions, opts...)
+	}
+}
+
+// WithSortedColumns sorts discovered columns by name instead of keeping them
+// in the order in which they were first seen. It applies to FromJSON, for
+// arrays of objects, FromJSONLines, FromLogfmt and, for label columns,
+// FromPrometheus.
+func WithSortedColumns() ImportOption {
+	return func(c *importConfig) {
+		c.sortColumns = true
+	}
+}
+
+// WithArraySeparator sets the separator used when joining array values into a
+// single cell by FromJSON and FromJSONLines. The default is ", ".
+func WithArraySeparator(sep string) ImportOption {
+	return func(c *importConfig) {
+		c.arraySeparator = sep
+	}
+}
+
+// WithAllowedColumns restricts the created table to the given columns. Any
+// other columns found in the input are ignored.
+func WithAllowedColumns(columns ...string) ImportOption {
+	return func(c *importConfig) {
+		c.allowedColumns = make(map[string]bool, len(columns))
+		for _, col := range columns {
+			c.allowedColumns[col] = true
+		}
+	}
+}
+
+// WithRowLimit sets the maximum number of rows read from the input. A limit of
+// zero or less means no limit.
+func WithRowLimit(limit int) ImportOption {
+	return func(c *importConfig) {
+		c.rowLimit = limit
+	}
+}
+
+// WithNullPlaceholder sets the cell value used by FromSQLRows for SQL NULL
+// values. The default is "NULL".
+func WithNullPlaceholder(placeholder string) ImportOption {
+	return func(c *importConfig) {
+		c.nullPlaceholder = placeholder
+	}
+}
+
+// WithTimeFormat sets the layout used by FromSQLRows to format time values.
+// The default is time.RFC3339.
+func WithTimeFormat(layout string) ImportOption {
+	return func(c *importConfig) {
+		c.timeFormat = layout
+	}
+}
+
+// WithSheetName selects the sheet, by name, that FromXLSX reads a table from.
+// By default, the first sheet is read.
+func WithSheetName(name string) ImportOption {
+	return func(c *importConfig) {
+		c.sheetName = name
+	}
+}
+
+// WithSheetIndex selects the sheet, by its zero-based position in the
+// workbook, that FromXLSX reads a table from. By default, the first sheet is
+// read.
+func WithSheetIndex(index int) ImportOption {
+	return func(c *importConfig) {
+		c.sheetIndex = index
+	}
+}
+
+// WithCellRange restricts FromXLSX to reading a range of cells of the sheet,
+// such as "B2:E20". The first row of the range is used as the header row.
+func WithCellRange(cellRange string) ImportOption {
+	return func(c *importConfig) {
+		c.cellRange = cellRange
+	}
+}
+
+// WithHeaderRow sets the one-based number of the sheet row holding the
+// headers for FromXLSX. Rows above it are ignored. By default, the header row
+// is detected as the first row with the most non-empty text cells among the
+// first rows of the sheet, which skips title rows.
+func WithHeaderRow(row int) ImportOption {
+	return func(c *importConfig) {
+		c.headerRow = row
+	}
+}
+
+// WithColumnBoundaries sets the zero-based character offsets at which the
+// columns of fixed-width text start, instead of letting FromFixedWidth infer
+// them. Text before the first offset is ignored.
+func WithColumnBoundaries(offsets ...int) ImportOption {
+	return func(c *importConfig) {
+		c.boundaries = offsets
+	}
+}
+
+// WithPreferredColumns places the given columns first, in the given order,
+// when columns are discovered from the input. Columns that are not found are
+// ignored, and the remaining columns follow in their usual order. It applies
+// to the same functions as WithSortedColumns.
+func WithPreferredColumns(columns ...string) ImportOption {
+	return func(c *importConfig) {
+		c.preferred = columns
+	}
+}
+
+// WithTableIndex selects a single table, by its zero-based position in the
+// document, when the input of FromHTML holds multiple tables. By default, all
+// tables are read.
+func WithTableIndex(index int) ImportOption {
+	return func(c *importConfig) {
+		c.tableIndex = index
+	}
+}
+
+// WithTableID selects a single table, by its id attribute, when the input of
+// FromHTML holds multiple tables. By default, all tables are read.
+func WithTableID(id string) ImportOption {
+	return func(c *importConfig) {
+		c.tableID = id
+	}
+}
+
+// WithRepeatedSpans makes FromHTML fill all the cells covered by a cell
+// spanning multiple columns or rows with its value. By default, only the first
+// cell gets the value and the others are left empty.
+func WithRepeatedSpans() ImportOption {
+	return func(c *importConfig) {
+		c.repeatSpans = true
+	}
+}
+
+// WithMetricPrefix restricts the table created by FromPrometheus to the
+// metrics whose names start with one of the given prefixes. By default, all
+// metrics are read.
+func WithMetricPrefix(prefixes ...string) ImportOption {
+	return func(c *importConfig) {
+		c.metricPrefixes = prefixes
+	}
+}
+
+// WithPivotLabel makes FromPrometheus turn the values of the given label into
+// columns, so that samples differing only in that label share a row.
+func WithPivotLabel(label string) ImportOption {
+	return func(c *importConfig) {
+		c.pivotLabel = label
+	}
+}
+
+// columnAllowed reports whether the column should be included in the table.
+func (c *importConfig) columnAllowed(column string) bool {
+	return c.allowedColumns == nil || c.allowedColumns[column]
+}
+
+// rowLimitReached reports whether n rows is at or above the row limit.
+func (c *importConfig) rowLimitReached(n int) bool {
+	return c.rowLimit > 0 && n >= c.rowLimit
+}
+
+// newTable creates a table with the given columns, rows and column alignments
+// using the writer and table options of the config. Columns that are not
+// allowed are removed and the rows are truncated to the row limit. The
+// alignments may be nil.
+func (c *importConfig) newTable(columns []string, rows [][]string, alignments []Alignment) *Table {
+	if c.rowLimitReached(len(rows)) {
+		rows = rows[:c.rowLimit]
+	}
+
+	if c.allowedColumns != nil {
+		var keep []int
+		for i, col := range columns {
+			if c.columnAllowed(col) {
+				keep = append(keep, i)
+			}
+		}
+		columns = pick(columns, keep)
+		for i, row := range rows {
+			rows[i] = pick(row, keep)
+		}
+		if alignments != nil {
+			alignments = pick(alignments, keep)
+		}
+	}
+
+	var opts []TableOption
+	if alignments != nil {
+		opts = append(opts, WithAlignments(alignments))
+	}
+	opts = append(opts, c.tableOptions...)
+
+	t := New(c.writer, columns, opts...)
+	t.AddRows(rows)
+
+	return t
+}
+
+// newKeyedTable creates a table from rows of key/value pairs. Columns are
+// ordered as described for orderColumns. Missing keys result in empty cells.
+func (c *importConfig) newKeyedTable(keys []string, records []map[string]string) *Table {
+	columns := c.orderColumns(keys)
+
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		row := make([]string, len(columns))
+		for j, col := range columns {
+			row[j] = record[col]
+		}
+		rows[i] = row
+	}
+
+	return c.newTable(columns, rows, nil)
+}
+
+// orderColumns returns the discovered columns with the preferred columns
+// first, followed by the others in the order in which they were found, or
+// sorted if requested.
+func (c *importConfig) orderColumns(columns []string) []string {
+	rest := slices.Clone(columns)
+	if c.sortColumns {
+		slices.Sort(rest)
+	}
+
+	ordered := make([]string, 0, len(columns))
+	for _, col := range c.preferred {
+		if i := slices.Index(rest, col); i >= 0 {
+			ordered = append(ordered, col)
+			rest = slices.Delete(rest, i, i+1)
+		}
+	}
+
+	return append(ordered, rest...)
+}
+
+// pick returns the values at the given indexes. Indexes beyond the end of
+// values result in zero values.
+func pick[T any](values []T, indexes []int) []T {
+	picked := make([]T, len(indexes))
+	for i, index := range indexes {
+		if index < len(values) {
+			picked[i] = values[index]
+		}
+	}
+
+	return picked
+}
@@ -0,0 +1,361 @@
+package tablr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// JSONFormat represents the layout of the JSON produced by RenderJSON.
+type JSONFormat uint8
+
+const (
+	// JSONObjects renders the rows as an array of objects keyed by column
+	// header. The headers must be unique.
+	JSONObjects JSONFormat = iota
+	// JSONDocument renders the table as an object with the columns, the
+	// column alignments and the rows as an array of arrays.
+	JSONDocument
+)
+
+// JSONOption represents an option for configuring JSON output.
+type JSONOption func(*jsonConfig)
+
+type jsonConfig struct {
+	format JSONFormat
+	indent string
+}
+
+// WithJSONFormat sets the layout of the JSON output. The default is
+// JSONObjects.
+func WithJSONFormat(format JSONFormat) JSONOption {
+	return func(c *jsonConfig) {
+		c.format = format
+	}
+}
+
+// WithJSONIndent sets the string used to indent the JSON output. By default
+// the output is compact.
+func WithJSONIndent(indent string) JSONOption {
+	return func(c *jsonConfig) {
+		c.indent = indent
+	}
+}
+
+// jsonDocument is the layout used by the JSONDocument format.
+type jsonDocument struct {
+	Columns    []string   `json:"columns"`
+	Alignments []string   `json:"alignments"`
+	Rows       [][]string `json:"rows"`
+}
+
+// RenderJSON renders the table as JSON to the given writer.
+func (t *Table) RenderJSON(w io.Writer, opts ...JSONOption) error {
+	c := &jsonConfig{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch c.format {
+	case JSONObjects:
+		data, err = t.jsonObjects()
+	case JSONDocument:
+		data, err = t.jsonDocument()
+	default:
+		return fmt.Errorf("invalid JSON format: %d", c.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if c.indent != "" {
+		if err := json.Indent(&buf, data, "", c.indent); err != nil {
+			return err
+		}
+	} else {
+		buf.Write(data)
+	}
+	buf.WriteByte('\n')
+
+	_, err = buf.WriteTo(w)
+
+	return err
+}
+
+// jsonObjects returns the rows as an array of objects keyed by column header.
+// The keys of each object are written in column order. An error is returned
+// if two columns have the same header, as one would overwrite the other.
+func (t *Table) jsonObjects() ([]byte, error) {
+	for i, col := range t.columns {
+		if j := slices.Index(t.columns[:i], col); j >= 0 {
+			return nil, fmt.Errorf("columns %d and %d have the same header %q", j, i, col)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteByte('[')
+	for i, row := range t.rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		obj := &jsonObject{values: make(map[string]any, len(t.columns))}
+		for j, col := range t.columns {
+			obj.set(col, row[j])
+		}
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+// jsonDocument returns the table in the JSONDocument layout.
+func (t *Table) jsonDocument() ([]byte, error) {
+	doc := jsonDocument{
+		Columns:    t.columns,
+		Alignments: make([]string, len(t.columnAlignments)),
+		Rows:       t.rows,
+	}
+	for i, a := range t.columnAlignments {
+		doc.Alignments[i] = a.String()
+	}
+
+	return json.Marshal(doc)
+}
+
+// FromJSON creates a table from a JSON array read from r.
+//
+// The array may contain objects or arrays. For an array of objects, each key
+// becomes a column, in the order in which the keys are first seen unless
+// WithSortedColumns is used. Nested objects are flattened using dotted keys,
+// e.g. {"a": {"b": 1}} becomes the column "a.b", and arrays are joined using
+// the separator set by WithArraySeparator. For an array of arrays, the first
+// array holds the column headers and the remaining arrays hold the rows.
+func FromJSON(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	v, err := decodeJSONValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after top-level JSON value")
+	}
+
+	values, ok := v.([]any)
+	if !ok {
+		return nil, errors.New("JSON value must be an array")
+	}
+
+	if len(values) == 0 {
+		return c.newTable(nil, nil, nil), nil
+	}
+
+	if _, ok := values[0].([]any); ok {
+		return c.fromJSONArrays(values)
+	}
+
+	var (
+		keys    []string
+		seen    = make(map[string]bool)
+		records = make([]map[string]string, len(values))
+	)
+	for i, v := range values {
+		obj, ok := v.(*jsonObject)
+		if !ok {
+			return nil, fmt.Errorf("element %d: expected JSON object", i)
+		}
+		record := make(map[string]string)
+		flattenJSON("", obj, c.arraySeparator, func(key, value string) {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+			record[key] = value
+		})
+		records[i] = record
+	}
+
+	return c.newKeyedTable(keys, records), nil
+}
+
+// fromJSONArrays creates a table from an array of arrays, where the first
+// array holds the column headers.
+func (c *importConfig) fromJSONArrays(values []any) (*Table, error) {
+	rows := make([][]string, len(values))
+	for i, v := range values {
+		arr, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("element %d: expected JSON array", i)
+		}
+		row := make([]string, len(arr))
+		for j, val := range arr {
+			row[j] = jsonString(val, c.arraySeparator)
+		}
+		rows[i] = row
+	}
+
+	return c.newTable(rows[0], rows[1:], nil), nil
+}
+
+// jsonObject is a decoded JSON object that remembers the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// set sets the value of key, appending key to the keys if it is new.
+func (o *jsonObject) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// MarshalJSON encodes the object with its keys in their original order.
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// decodeJSONValue decodes the next JSON value from dec. Objects are returned
+// as *jsonObject, arrays as []any and numbers as json.Number, provided that
+// dec.UseNumber has been called.
+func decodeJSONValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := &jsonObject{values: make(map[string]any)}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := tok.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected JSON object key: %v", tok)
+			}
+			val, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.set(key, val)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		arr := make([]any, 0)
+		for dec.More() {
+			val, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, val)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON delimiter: %v", delim)
+}
+
+// flattenJSON calls fn for each scalar or array value in obj. Keys of nested
+// objects are joined with a dot.
+func flattenJSON(prefix string, obj *jsonObject, sep string, fn func(key, value string)) {
+	for _, key := range obj.keys {
+		val := obj.values[key]
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := val.(*jsonObject); ok {
+			flattenJSON(key, nested, sep, fn)
+			continue
+		}
+		fn(key, jsonString(val, sep))
+	}
+}
+
+// jsonString returns the cell value for a decoded JSON value.
+func jsonString(v any, sep string) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case []any:
+		values := make([]string, len(v))
+		for i, val := range v {
+			values[i] = jsonString(val, sep)
+		}
+		return strings.Join(values, sep)
+	case *jsonObject:
+		data, err := v.MarshalJSON()
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,239 @@
+package tablr_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.JSONOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Objects",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane | Smith", "25", "Los Angeles"},
+			},
+			want: `[{"Name":"John Doe","Age":"30","City":"New York"},{"Name":"Jane | Smith","Age":"25","City":"Los Angeles"}]
+`,
+		},
+		{
+			name:    "Objects without rows",
+			columns: []string{"Name"},
+			want: `[]
+`,
+		},
+		{
+			name:    "Document",
+			columns: []string{"Name", "Age"},
+			options: []tablr.JSONOption{tablr.WithJSONFormat(tablr.JSONDocument)},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			want: `{"columns":["Name","Age"],"alignments":["left","right"],"rows":[["John Doe","30"]]}
+`,
+		},
+		{
+			name:    "Indented",
+			columns: []string{"Name", "Age"},
+			options: []tablr.JSONOption{tablr.WithJSONIndent("  ")},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			want: `[
+  {
+    "Name": "John Doe",
+    "Age": "30"
+  }
+]
+`,
+		},
+		{
+			name:    "Invalid format",
+			columns: []string{"Name"},
+			options: []tablr.JSONOption{tablr.WithJSONFormat(tablr.JSONFormat(42))},
+			wantErr: true,
+		},
+		{
+			name:    "Objects with duplicate headers",
+			columns: []string{"Name", "Age", "Name"},
+			rows: [][]string{
+				{"John", "30", "Doe"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Document with duplicate headers",
+			columns: []string{"Name", "Name"},
+			options: []tablr.JSONOption{tablr.WithJSONFormat(tablr.JSONDocument)},
+			rows: [][]string{
+				{"John", "Doe"},
+			},
+			want: `{"columns":["Name","Name"],"alignments":["left","right"],"rows":[["John","Doe"]]}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignment(0, tablr.AlignLeft), tablr.WithAlignment(1, tablr.AlignRight))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderJSON(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderJSON() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		options     []tablr.ImportOption
+		wantColumns []string
+		wantRows    [][]string
+		wantErr     bool
+	}{
+		{
+			name:        "Array of objects",
+			input:       `[{"name":"John","age":30},{"name":"Jane","city":"Paris","age":25.5}]`,
+			wantColumns: []string{"name", "age", "city"},
+			wantRows: [][]string{
+				{"John", "30", ""},
+				{"Jane", "25.5", "Paris"},
+			},
+		},
+		{
+			name:        "Sorted columns",
+			input:       `[{"name":"John","age":30},{"city":"Paris"}]`,
+			options:     []tablr.ImportOption{tablr.WithSortedColumns()},
+			wantColumns: []string{"age", "city", "name"},
+			wantRows: [][]string{
+				{"30", "", "John"},
+				{"", "Paris", ""},
+			},
+		},
+		{
+			name:        "Nested objects and arrays",
+			input:       `[{"id":1,"owner":{"name":"John","address":{"city":"Oslo"}},"tags":["a","b"],"ok":true,"none":null}]`,
+			wantColumns: []string{"id", "owner.name", "owner.address.city", "tags", "ok", "none"},
+			wantRows: [][]string{
+				{"1", "John", "Oslo", "a, b", "true", ""},
+			},
+		},
+		{
+			name:        "Array separator",
+			input:       `[{"tags":["a","b",{"c":1}]}]`,
+			options:     []tablr.ImportOption{tablr.WithArraySeparator(";")},
+			wantColumns: []string{"tags"},
+			wantRows: [][]string{
+				{`a;b;{"c":1}`},
+			},
+		},
+		{
+			name:        "Array of arrays",
+			input:       `[["name","age"],["John",30],["Jane"]]`,
+			wantColumns: []string{"name", "age"},
+			wantRows: [][]string{
+				{"John", "30"},
+				{"Jane", ""},
+			},
+		},
+		{
+			name:  "Allowed columns and row limit",
+			input: `[["name","age","city"],["John",30,"Oslo"],["Jane",25,"Paris"]]`,
+			options: []tablr.ImportOption{
+				tablr.WithAllowedColumns("city", "name"),
+				tablr.WithRowLimit(1),
+			},
+			wantColumns: []string{"name", "city"},
+			wantRows: [][]string{
+				{"John", "Oslo"},
+			},
+		},
+		{
+			name:        "Empty array",
+			input:       `[]`,
+			wantColumns: []string{},
+			wantRows:    [][]string{},
+		},
+		{
+			name:    "Not an array",
+			input:   `{"name":"John"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Mixed elements",
+			input:   `[{"name":"John"},["Jane"]]`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid JSON",
+			input:   `[{"name":}]`,
+			wantErr: true,
+		},
+		{
+			name:    "Trailing data",
+			input:   `[] []`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromJSON(strings.NewReader(tt.input), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromJSON() columns = %v, want %v", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromJSON() rows = %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestFromJSON_RoundTrip(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age"})
+	table.AddRow([]string{"John | Doe", "30"})
+
+	var buf bytes.Buffer
+	if err := table.RenderJSON(&buf); err != nil {
+		t.Fatalf("RenderJSON() error = %v", err)
+	}
+
+	got, err := tablr.FromJSON(&buf, tablr.WithWriter(&bytes.Buffer{}))
+	if err != nil {
+		t.Fatalf("FromJSON() error = %v", err)
+	}
+	if !equalSlices(got.GetColumns(), table.GetColumns()) {
+		t.Errorf("columns = %v, want %v", got.GetColumns(), table.GetColumns())
+	}
+	if !equalRows(got.GetRows(), table.GetRows()) {
+		t.Errorf("rows = %v, want %v", got.GetRows(), table.GetRows())
+	}
+}
@@ -0,0 +1,100 @@
+package tablr
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// FromJSONLines creates a table from JSON Lines (NDJSON) read from r, with one
+// JSON object per line. Blank lines are ignored.
+//
+// Columns are added as new keys are found, and rows read before a column was
+// added get an empty cell for it. Nested objects and arrays are handled as
+// described for FromJSON. Errors include the line number on which they
+// occurred.
+func FromJSONLines(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	t := New(c.writer, nil)
+	index := make(map[string]int)
+
+	br := bufio.NewReader(r)
+	for line := 1; !c.rowLimitReached(len(t.rows)); line++ {
+		data, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			obj, decodeErr := decodeJSONLine(data)
+			if decodeErr != nil {
+				return nil, fmt.Errorf("line %d: %w", line, decodeErr)
+			}
+
+			row := make([]string, len(t.columns))
+			flattenJSON("", obj, c.arraySeparator, func(key, value string) {
+				if !c.columnAllowed(key) {
+					return
+				}
+				i, ok := index[key]
+				if !ok {
+					i = len(t.columns)
+					index[key] = i
+					t.addColumnInternal(key, &column{
+						alignment:       AlignDefault,
+						headerAlignment: AlignDefault,
+					})
+					row = append(row, "")
+				}
+				row[i] = value
+			})
+			t.addRowInternal(row)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	t.adjustColumnWidths()
+
+	if c.sortColumns || len(c.preferred) > 0 {
+		ordered := c.orderColumns(t.columns)
+		newOrder := make([]int, len(ordered))
+		for i, col := range ordered {
+			newOrder[i] = index[col]
+		}
+		if err := t.ReorderColumns(newOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, opt := range c.tableOptions {
+		opt(t)
+	}
+
+	return t, nil
+}
+
+// decodeJSONLine decodes a single line holding a JSON object.
+func decodeJSONLine(data []byte) (*jsonObject, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	v, err := decodeJSONValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON object")
+	}
+
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return nil, errors.New("expected JSON object")
+	}
+
+	return obj, nil
+}
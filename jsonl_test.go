@@ -0,0 +1,165 @@
+package tablr_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestFromJSONLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		options     []tablr.ImportOption
+		wantColumns []string
+		wantRows    [][]string
+		wantErr     string
+	}{
+		{
+			name: "Columns grow with new keys",
+			input: `{"level":"info","msg":"started"}
+{"level":"warn","msg":"slow","dur":3.5}
+
+{"msg":"done","req":{"id":7}}
+`,
+			wantColumns: []string{"level", "msg", "dur", "req.id"},
+			wantRows: [][]string{
+				{"info", "started", "", ""},
+				{"warn", "slow", "3.5", ""},
+				{"", "done", "", "7"},
+			},
+		},
+		{
+			name:        "No trailing newline",
+			input:       `{"a":1}`,
+			wantColumns: []string{"a"},
+			wantRows: [][]string{
+				{"1"},
+			},
+		},
+		{
+			name: "Allowed columns",
+			input: `{"level":"info","msg":"started","pid":1}
+{"level":"warn","msg":"slow"}
+`,
+			options:     []tablr.ImportOption{tablr.WithAllowedColumns("msg", "level")},
+			wantColumns: []string{"level", "msg"},
+			wantRows: [][]string{
+				{"info", "started"},
+				{"warn", "slow"},
+			},
+		},
+		{
+			name: "Row limit",
+			input: `{"n":1}
+{"n":2}
+{"n":3,"extra":true}
+`,
+			options:     []tablr.ImportOption{tablr.WithRowLimit(2)},
+			wantColumns: []string{"n"},
+			wantRows: [][]string{
+				{"1"},
+				{"2"},
+			},
+		},
+		{
+			name: "Sorted columns",
+			input: `{"b":1,"a":2}
+{"c":3}
+`,
+			options:     []tablr.ImportOption{tablr.WithSortedColumns()},
+			wantColumns: []string{"a", "b", "c"},
+			wantRows: [][]string{
+				{"2", "1", ""},
+				{"", "", "3"},
+			},
+		},
+		{
+			name: "Preferred columns",
+			input: `{"b":1,"a":2}
+{"c":3}
+`,
+			options:     []tablr.ImportOption{tablr.WithPreferredColumns("c", "a")},
+			wantColumns: []string{"c", "a", "b"},
+			wantRows: [][]string{
+				{"", "2", "1"},
+				{"3", "", ""},
+			},
+		},
+		{
+			name:        "Empty input",
+			input:       "",
+			wantColumns: []string{},
+			wantRows:    [][]string{},
+		},
+		{
+			name: "Decode error",
+			input: `{"a":1}
+{"a":
+`,
+			wantErr: "line 2:",
+		},
+		{
+			name: "Not an object",
+			input: `{"a":1}
+{"a":2}
+[1,2]
+`,
+			wantErr: "line 3: expected JSON object",
+		},
+		{
+			name:    "Multiple values on a line",
+			input:   `{"a":1} {"a":2}`,
+			wantErr: "line 1: unexpected data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromJSONLines(strings.NewReader(tt.input), tt.options...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("FromJSONLines() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromJSONLines() error = %v", err)
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromJSONLines() columns = %v, want %v", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromJSONLines() rows = %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestFromJSONLines_Render(t *testing.T) {
+	input := `{"name":"John","age":30}
+{"name":"Jane","age":25,"city":"Los Angeles"}
+`
+	var buf bytes.Buffer
+	table, err := tablr.FromJSONLines(
+		strings.NewReader(input),
+		tablr.WithWriter(&buf),
+		tablr.WithTableOptions(tablr.WithAlignment(1, tablr.AlignRight)),
+	)
+	if err != nil {
+		t.Fatalf("FromJSONLines() error = %v", err)
+	}
+
+	table.Render()
+
+	want := `| name | age | city        |
+|------|----:|-------------|
+| John |  30 |             |
+| Jane |  25 | Los Angeles |
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Render() got = \n%v, want \n%v", got, want)
+	}
+}
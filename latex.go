@@ -0,0 +1,191 @@
+package tablr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LaTeXOption represents an option for configuring LaTeX output.
+type LaTeXOption func(*latexConfig)
+
+type latexConfig struct {
+	caption            string
+	label              string
+	booktabs           bool
+	longtableThreshold int
+}
+
+// WithLaTeXCaption sets the caption of the table. The default is the caption
+// set on the table.
+func WithLaTeXCaption(caption string) LaTeXOption {
+	return func(c *latexConfig) {
+		c.caption = caption
+	}
+}
+
+// WithLaTeXLabel sets the label used to reference the table. The default is
+// the label set on the table.
+func WithLaTeXLabel(label string) LaTeXOption {
+	return func(c *latexConfig) {
+		c.label = label
+	}
+}
+
+// WithLaTeXBooktabs sets whether the rules of the booktabs package are used.
+// If disabled, \hline is used instead. Booktabs rules are used by default.
+func WithLaTeXBooktabs(booktabs bool) LaTeXOption {
+	return func(c *latexConfig) {
+		c.booktabs = booktabs
+	}
+}
+
+// WithLaTeXLongtable makes the table use the longtable environment, which
+// can span multiple pages, when it has more than the given number of rows. A
+// threshold of zero or less disables longtable, which is the default.
+func WithLaTeXLongtable(threshold int) LaTeXOption {
+	return func(c *latexConfig) {
+		c.longtableThreshold = threshold
+	}
+}
+
+// latexReplacer escapes the characters that are special in LaTeX.
+var latexReplacer = strings.NewReplacer(
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`\`, `\textbackslash{}`,
+)
+
+// RenderLaTeX renders the table as a LaTeX tabular, or longtable, to the
+// given writer.
+func (t *Table) RenderLaTeX(w io.Writer, opts ...LaTeXOption) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	c := &latexConfig{
+		caption:  t.caption,
+		label:    t.label,
+		booktabs: true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	top, mid, bottom := `\hline`, `\hline`, `\hline`
+	if c.booktabs {
+		top, mid, bottom = `\toprule`, `\midrule`, `\bottomrule`
+	}
+
+	spec := t.latexColumnSpec()
+	header := t.latexHeader()
+	bw := bufio.NewWriter(w)
+
+	if c.longtableThreshold > 0 && len(t.rows) > c.longtableThreshold {
+		fmt.Fprintf(bw, "\\begin{longtable}{%s}\n", spec)
+		head := fmt.Sprintf("%s\n%s \\\\\n%s\n", top, header, mid)
+		if c.caption != "" || c.label != "" {
+			// The caption and label belong on the first page only, so the
+			// header repeated on later pages is written separately.
+			c.writeCaption(bw, "")
+			bw.WriteString(" \\\\\n")
+			bw.WriteString(head + "\\endfirsthead\n")
+		}
+		bw.WriteString(head + "\\endhead\n")
+		fmt.Fprintf(bw, "%s\n\\endfoot\n", bottom)
+		t.writeLaTeXRows(bw, "")
+		bw.WriteString("\\end{longtable}\n")
+
+		return bw.Flush()
+	}
+
+	indent := ""
+	if c.caption != "" || c.label != "" {
+		indent = "  "
+		bw.WriteString("\\begin{table}\n  \\centering\n")
+		c.writeCaption(bw, indent)
+		bw.WriteString("\n")
+	}
+
+	fmt.Fprintf(bw, "%s\\begin{tabular}{%s}\n", indent, spec)
+	fmt.Fprintf(bw, "%s%s\n%s%s \\\\\n%s%s\n", indent, top, indent, header, indent, mid)
+	t.writeLaTeXRows(bw, indent)
+	fmt.Fprintf(bw, "%s%s\n%s\\end{tabular}\n", indent, bottom, indent)
+
+	if indent != "" {
+		bw.WriteString("\\end{table}\n")
+	}
+
+	return bw.Flush()
+}
+
+// writeCaption writes the caption and label, if any, on a single line without
+// a line break.
+func (c *latexConfig) writeCaption(w *bufio.Writer, indent string) {
+	var parts []string
+	if c.caption != "" {
+		parts = append(parts, `\caption{`+escapeLaTeX(c.caption)+`}`)
+	}
+	if c.label != "" {
+		parts = append(parts, `\label{`+c.label+`}`)
+	}
+	w.WriteString(indent + strings.Join(parts, ""))
+}
+
+// latexColumnSpec returns the column specification derived from the column
+// alignments, e.g. "lcr".
+func (t *Table) latexColumnSpec() string {
+	var sb strings.Builder
+	for _, a := range t.columnAlignments {
+		sb.WriteByte(latexAlignment(a))
+	}
+	return sb.String()
+}
+
+// latexHeader returns the header row without the trailing line break. Headers
+// aligned differently from their column are wrapped in \multicolumn.
+func (t *Table) latexHeader() string {
+	cells := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		cells[i] = escapeLaTeX(col)
+		if a := latexAlignment(t.headerAlignments[i]); a != latexAlignment(t.columnAlignments[i]) {
+			cells[i] = fmt.Sprintf(`\multicolumn{1}{%c}{%s}`, a, cells[i])
+		}
+	}
+	return strings.Join(cells, " & ")
+}
+
+// writeLaTeXRows writes the data rows.
+func (t *Table) writeLaTeXRows(w *bufio.Writer, indent string) {
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escapeLaTeX(cell)
+		}
+		fmt.Fprintf(w, "%s%s \\\\\n", indent, strings.Join(cells, " & "))
+	}
+}
+
+// latexAlignment returns the LaTeX column type for the alignment.
+func latexAlignment(a Alignment) byte {
+	switch a {
+	case AlignCenter:
+		return 'c'
+	case AlignRight:
+		return 'r'
+	}
+	return 'l'
+}
+
+// escapeLaTeX escapes the characters that are special in LaTeX.
+func escapeLaTeX(s string) string {
+	return latexReplacer.Replace(s)
+}
@@ -0,0 +1,205 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderLaTeX(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.LaTeXOption
+		want    string
+	}{
+		{
+			name:    "Booktabs tabular",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `\begin{tabular}{lcr}
+\toprule
+Name & Age & City \\
+\midrule
+John Doe & 30 & New York \\
+Jane Smith & 25 & Los Angeles \\
+\bottomrule
+\end{tabular}
+`,
+		},
+		{
+			name:    "Escaping",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{`A & B_C`, `50% of $3`, `#{x}~^\`},
+			},
+			want: `\begin{tabular}{lcr}
+\toprule
+Name & Age & City \\
+\midrule
+A \& B\_C & 50\% of \$3 & \#\{x\}\textasciitilde{}\textasciicircum{}\textbackslash{} \\
+\bottomrule
+\end{tabular}
+`,
+		},
+		{
+			name:    "Caption, label and hline",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.LaTeXOption{
+				tablr.WithLaTeXCaption("People & places"),
+				tablr.WithLaTeXLabel("tab:people"),
+				tablr.WithLaTeXBooktabs(false),
+			},
+			want: `\begin{table}
+  \centering
+  \caption{People \& places}\label{tab:people}
+  \begin{tabular}{lcr}
+  \hline
+  Name & Age & City \\
+  \hline
+  John Doe & 30 & New York \\
+  \hline
+  \end{tabular}
+\end{table}
+`,
+		},
+		{
+			name:    "Longtable",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			options: []tablr.LaTeXOption{
+				tablr.WithLaTeXLongtable(1),
+				tablr.WithLaTeXCaption("People"),
+			},
+			want: `\begin{longtable}{lcr}
+\caption{People} \\
+\toprule
+Name & Age & City \\
+\midrule
+\endfirsthead
+\toprule
+Name & Age & City \\
+\midrule
+\endhead
+\bottomrule
+\endfoot
+John Doe & 30 & New York \\
+Jane Smith & 25 & Los Angeles \\
+\end{longtable}
+`,
+		},
+		{
+			name:    "Longtable without caption",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			options: []tablr.LaTeXOption{tablr.WithLaTeXLongtable(1)},
+			want: `\begin{longtable}{lcr}
+\toprule
+Name & Age & City \\
+\midrule
+\endhead
+\bottomrule
+\endfoot
+John Doe & 30 & New York \\
+Jane Smith & 25 & Los Angeles \\
+\end{longtable}
+`,
+		},
+		{
+			name:    "Longtable threshold not reached",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.LaTeXOption{tablr.WithLaTeXLongtable(1)},
+			want: `\begin{tabular}{lcr}
+\toprule
+Name & Age & City \\
+\midrule
+John Doe & 30 & New York \\
+\bottomrule
+\end{tabular}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(defaultAlignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			if err := table.RenderLaTeX(&buf, tt.options...); err != nil {
+				t.Fatalf("RenderLaTeX() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderLaTeX() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderLaTeX_HeaderAlignment(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age"},
+		tablr.WithAlignment(1, tablr.AlignRight),
+		tablr.WithHeaderAlignment(1, tablr.AlignCenter),
+	)
+	table.AddRow([]string{"John", "30"})
+
+	var buf bytes.Buffer
+	if err := table.RenderLaTeX(&buf); err != nil {
+		t.Fatalf("RenderLaTeX() error = %v", err)
+	}
+
+	want := `\begin{tabular}{lr}
+\toprule
+Name & \multicolumn{1}{c}{Age} \\
+\midrule
+John & 30 \\
+\bottomrule
+\end{tabular}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderLaTeX() got = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestTable_RenderLaTeX_TableCaption(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name"}, tablr.WithCaption("People", "tab:people"))
+	table.AddRow([]string{"John"})
+
+	var buf bytes.Buffer
+	if err := table.RenderLaTeX(&buf, tablr.WithLaTeXLabel("tab:override")); err != nil {
+		t.Fatalf("RenderLaTeX() error = %v", err)
+	}
+
+	want := `\begin{table}
+  \centering
+  \caption{People}\label{tab:override}
+  \begin{tabular}{l}
+  \toprule
+  Name \\
+  \midrule
+  John \\
+  \bottomrule
+  \end{tabular}
+\end{table}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderLaTeX() got = \n%v, want \n%v", got, want)
+	}
+}
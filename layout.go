@@ -0,0 +1,306 @@
+package tablr
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LayoutOption represents an option for configuring how a table is fitted to
+// a width.
+type LayoutOption func(*layoutConfig)
+
+type layoutConfig struct {
+	width      int
+	separator  int
+	margin     int
+	priorities map[string]int
+	minWidths  map[string]int
+}
+
+// WithLayoutWidth sets the total width to fit the table to. The default is the
+// value of the COLUMNS environment variable or, if it is not set, 80.
+func WithLayoutWidth(width int) LayoutOption {
+	return func(c *layoutConfig) {
+		c.width = width
+	}
+}
+
+// WithLayoutSeparator sets the width of the space between two columns, e.g.
+// the gap of RenderPlain or, for RenderBox, the padding on either side plus
+// the border. The default is 3, which matches both renderers' defaults.
+func WithLayoutSeparator(width int) LayoutOption {
+	return func(c *layoutConfig) {
+		c.separator = width
+	}
+}
+
+// WithLayoutMargin sets the width taken up by the left and right edges of the
+// table, e.g. 4 for RenderBox with its default padding. The default is 0,
+// which matches RenderPlain.
+func WithLayoutMargin(width int) LayoutOption {
+	return func(c *layoutConfig) {
+		c.margin = width
+	}
+}
+
+// WithColumnPriority sets the priority of the column with the given header.
+// Columns with a lower priority are shrunk first and, if the table still does
+// not fit, hidden. The default priority is 0.
+func WithColumnPriority(column string, priority int) LayoutOption {
+	return func(c *layoutConfig) {
+		c.priorities[column] = priority
+	}
+}
+
+// WithLayoutMinWidth sets the width below which the column with the given
+// header is not shrunk. The default is the smaller of 10 and the width of the
+// widest cell in the column.
+func WithLayoutMinWidth(column string, width int) LayoutOption {
+	return func(c *layoutConfig) {
+		c.minWidths[column] = width
+	}
+}
+
+// defaultLayoutMinWidth is the default width below which columns are not
+// shrunk.
+const defaultLayoutMinWidth = 10
+
+// Fit returns a copy of the table that fits the configured width when
+// rendered by RenderPlain or RenderBox, along with the headers of the columns
+// that had to be hidden.
+//
+// Columns wider than their share of the width are shrunk, lowest priority
+// first and widest first within a priority, and their cells are wrapped at
+// word boundaries onto multiple lines. If the columns do not fit even at their
+// minimum widths, columns are hidden, lowest priority and rightmost first,
+// until they do. The first column with the highest priority is never hidden.
+// The theme of the table is kept, applying column and cell styles to the
+// columns they were set for.
+func (t *Table) Fit(opts ...LayoutOption) (*Table, []string, error) {
+	c := &layoutConfig{
+		width:      terminalWidth(),
+		separator:  3,
+		priorities: make(map[string]int),
+		minWidths:  make(map[string]int),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.width <= 0 {
+		return nil, nil, fmt.Errorf("invalid width: %d", c.width)
+	}
+	if c.separator < 0 || c.margin < 0 {
+		return nil, nil, fmt.Errorf("invalid separator or margin: %d, %d", c.separator, c.margin)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	natural := make([]int, len(t.columns))
+	minimum := make([]int, len(t.columns))
+	for i, col := range t.columns {
+		natural[i] = displayWidth(col)
+		for _, row := range t.rows {
+			natural[i] = max(natural[i], displayWidth(row[i]))
+		}
+
+		minimum[i] = min(natural[i], defaultLayoutMinWidth)
+		if w, ok := c.minWidths[col]; ok {
+			minimum[i] = min(natural[i], max(w, 1))
+		}
+	}
+
+	visible := c.visibleColumns(t.columns, minimum)
+	widths := c.shrink(t.columns, visible, natural, minimum)
+
+	var hidden []string
+	var index []int
+	columns := make([]string, 0, len(visible))
+	headerAlignments := make([]Alignment, 0, len(visible))
+	columnAlignments := make([]Alignment, 0, len(visible))
+	for i, col := range t.columns {
+		if !visible[i] {
+			hidden = append(hidden, col)
+			continue
+		}
+		index = append(index, i)
+		columns = append(columns, wrapText(col, widths[i]))
+		headerAlignments = append(headerAlignments, t.headerAlignments[i])
+		columnAlignments = append(columnAlignments, t.columnAlignments[i])
+	}
+
+	rows := make([][]string, len(t.rows))
+	for r, row := range t.rows {
+		rows[r] = make([]string, 0, len(columns))
+		for i, cell := range row {
+			if visible[i] {
+				rows[r] = append(rows[r], wrapText(cell, widths[i]))
+			}
+		}
+	}
+
+	fitted := New(t.writer, columns,
+		WithAlignments(columnAlignments),
+		WithHeaderAlignments(headerAlignments),
+		WithCaption(t.caption, t.label),
+		WithTheme(t.fitTheme(columns, index)),
+		WithColor(t.colorMode),
+	)
+	fitted.AddRows(rows)
+
+	return fitted, hidden, nil
+}
+
+// fitTheme returns the theme of the table adapted to the fitted columns,
+// where index holds the index of the original column of each fitted column.
+// Column styles are keyed by the wrapped headers, and the Cell function is
+// called with the original column index and cell value.
+func (t *Table) fitTheme(columns []string, index []int) Theme {
+	theme := t.theme
+
+	if t.theme.Columns != nil {
+		theme.Columns = make(map[string]Style, len(columns))
+		for j, i := range index {
+			if style, ok := t.theme.Columns[t.columns[i]]; ok {
+				theme.Columns[columns[j]] = style
+			}
+		}
+	}
+
+	if cell := t.theme.Cell; cell != nil {
+		values := make([][]string, len(t.rows))
+		for r, row := range t.rows {
+			values[r] = make([]string, len(index))
+			for j, i := range index {
+				values[r][j] = row[i]
+			}
+		}
+		theme.Cell = func(row, col int, value string) Style {
+			if row < len(values) {
+				value = values[row][col]
+			}
+			return cell(row, index[col], value)
+		}
+	}
+
+	return theme
+}
+
+// total returns the width of the table with the given column widths.
+func (c *layoutConfig) total(widths []int, visible []bool) int {
+	total, n := c.margin, 0
+	for i, w := range widths {
+		if visible[i] {
+			total += w
+			n++
+		}
+	}
+	if n > 1 {
+		total += c.separator * (n - 1)
+	}
+	return total
+}
+
+// visibleColumns returns which columns are shown, hiding the lowest priority
+// columns until the table fits at the minimum widths.
+func (c *layoutConfig) visibleColumns(columns []string, minimum []int) []bool {
+	visible := make([]bool, len(columns))
+	for i := range visible {
+		visible[i] = true
+	}
+
+	for n := len(columns); n > 1 && c.total(minimum, visible) > c.width; n-- {
+		drop := -1
+		for i := len(columns) - 1; i >= 0; i-- {
+			if visible[i] && (drop < 0 || c.priorities[columns[i]] < c.priorities[columns[drop]]) {
+				drop = i
+			}
+		}
+		visible[drop] = false
+	}
+
+	return visible
+}
+
+// shrink returns the column widths, shrinking the visible columns from their
+// natural widths towards their minimum widths until the table fits. Columns
+// are shrunk one character at a time, taking from the widest column with the
+// lowest priority that can still be shrunk.
+func (c *layoutConfig) shrink(columns []string, visible []bool, natural, minimum []int) []int {
+	widths := make([]int, len(natural))
+	copy(widths, natural)
+
+	for excess := c.total(widths, visible) - c.width; excess > 0; excess-- {
+		widest := -1
+		for i, w := range widths {
+			if !visible[i] || w <= minimum[i] {
+				continue
+			}
+			if widest < 0 {
+				widest = i
+				continue
+			}
+			p, q := c.priorities[columns[i]], c.priorities[columns[widest]]
+			if p < q || (p == q && w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+	}
+
+	return widths
+}
+
+// wrapText wraps s at word boundaries so that no line is wider than width.
+// Words wider than width are broken. Existing line breaks are kept.
+func wrapText(s string, width int) string {
+	if displayWidth(s) <= width {
+		return s
+	}
+
+	var lines []string
+	for _, line := range cellLines(s) {
+		var current []rune
+		currentWidth := 0
+		for _, word := range strings.Fields(line) {
+			wordWidth := displayWidth(word)
+			if currentWidth > 0 && currentWidth+1+wordWidth <= width {
+				current = append(append(current, ' '), []rune(word)...)
+				currentWidth += 1 + wordWidth
+				continue
+			}
+			if currentWidth > 0 {
+				lines = append(lines, string(current))
+				current, currentWidth = nil, 0
+			}
+			for _, r := range word {
+				rw := runeWidth(r)
+				if currentWidth+rw > width && currentWidth > 0 {
+					lines = append(lines, string(current))
+					current, currentWidth = nil, 0
+				}
+				current = append(current, r)
+				currentWidth += rw
+			}
+		}
+		lines = append(lines, string(current))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// terminalWidth returns the width of the terminal as given by the COLUMNS
+// environment variable, or 80 if it is not set.
+func terminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return 80
+}
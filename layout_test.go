@@ -0,0 +1,198 @@
+package tablr_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_Fit(t *testing.T) {
+	columns := []string{"Name", "Description", "Status"}
+	rows := [][]string{
+		{"web-1", "Serves the public website behind the load balancer", "Running"},
+		{"worker-2", "Processes background jobs", "Pending"},
+	}
+
+	tests := []struct {
+		name       string
+		options    []tablr.LayoutOption
+		want       string
+		wantHidden []string
+		wantErr    bool
+	}{
+		{
+			name:    "Fits without changes",
+			options: []tablr.LayoutOption{tablr.WithLayoutWidth(80)},
+			want: `Name       Description                                          Status
+web-1      Serves the public website behind the load balancer   Running
+worker-2   Processes background jobs                            Pending
+`,
+		},
+		{
+			name:    "Wraps the widest column",
+			options: []tablr.LayoutOption{tablr.WithLayoutWidth(50)},
+			want: `Name       Description                 Status
+web-1      Serves the public website   Running
+           behind the load balancer
+worker-2   Processes background jobs   Pending
+`,
+		},
+		{
+			name: "Shrinks low priority columns first",
+			options: []tablr.LayoutOption{
+				tablr.WithLayoutWidth(60),
+				tablr.WithColumnPriority("Name", -1),
+				tablr.WithLayoutMinWidth("Name", 4),
+			},
+			want: `Name   Description                                 Status
+web-   Serves the public website behind the load   Running
+1      balancer
+work   Processes background jobs                   Pending
+er-2
+`,
+		},
+		{
+			name: "Hides low priority columns",
+			options: []tablr.LayoutOption{
+				tablr.WithLayoutWidth(30),
+				tablr.WithColumnPriority("Name", 1),
+				tablr.WithColumnPriority("Status", 1),
+			},
+			want: `Name       Status
+web-1      Running
+worker-2   Pending
+`,
+			wantHidden: []string{"Description"},
+		},
+		{
+			name:       "Keeps one column",
+			options:    []tablr.LayoutOption{tablr.WithLayoutWidth(5), tablr.WithLayoutMinWidth("Name", 5)},
+			want:       "Name\nweb-1\nworke\nr-2\n",
+			wantHidden: []string{"Description", "Status"},
+		},
+		{
+			name:    "Invalid width",
+			options: []tablr.LayoutOption{tablr.WithLayoutWidth(0)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, columns)
+			table.AddRows(rows)
+
+			fitted, hidden, err := table.Fit(tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Fit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !equalSlices(hidden, tt.wantHidden) {
+				t.Errorf("Fit() hidden = %v, want %v", hidden, tt.wantHidden)
+			}
+
+			var buf bytes.Buffer
+			if err := fitted.RenderPlain(&buf); err != nil {
+				t.Fatalf("RenderPlain() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Fit() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_Fit_Box(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Key", "Value"})
+	table.AddRow([]string{"motd", "hello there general kenobi"})
+
+	fitted, _, err := table.Fit(tablr.WithLayoutWidth(24), tablr.WithLayoutMargin(4))
+	if err != nil {
+		t.Fatalf("Fit() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := fitted.RenderBox(&buf, tablr.WithBoxStyle(tablr.BoxASCII)); err != nil {
+		t.Fatalf("RenderBox() error = %v", err)
+	}
+
+	want := `+------+-------------+
+| Key  | Value       |
++------+-------------+
+| motd | hello there |
+|      | general     |
+|      | kenobi      |
++------+-------------+
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderBox() got = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestTable_Fit_Columns(t *testing.T) {
+	t.Setenv("COLUMNS", "20")
+
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Description"})
+	table.AddRow([]string{"web-1", "Serves the public website"})
+
+	fitted, _, err := table.Fit()
+	if err != nil {
+		t.Fatalf("Fit() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := fitted.RenderPlain(&buf); err != nil {
+		t.Fatalf("RenderPlain() error = %v", err)
+	}
+
+	want := "Name    Description\nweb-1   Serves the\n        public\n        website\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Fit() got = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestTable_Fit_Theme(t *testing.T) {
+	theme := tablr.Theme{
+		Columns: map[string]tablr.Style{"Long Header": tablr.StyleGreen},
+		Cell: func(_, col int, value string) tablr.Style {
+			if col == 2 && strings.HasPrefix(value, "-") {
+				return tablr.StyleRed
+			}
+			return ""
+		},
+	}
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Long Header", "Delta"},
+		tablr.WithTheme(theme),
+		tablr.WithColor(tablr.ColorAlways),
+	)
+	table.AddRows([][]string{{"web-1", "a", "-1"}, {"web-2", "b", "2"}})
+
+	fitted, hidden, err := table.Fit(
+		tablr.WithLayoutWidth(14),
+		tablr.WithColumnPriority("Name", -1),
+		tablr.WithLayoutMinWidth("Long Header", 4),
+	)
+	if err != nil {
+		t.Fatalf("Fit() error = %v", err)
+	}
+	if !equalSlices(hidden, []string{"Name"}) {
+		t.Fatalf("Fit() hidden = %q, want [Name]", hidden)
+	}
+
+	var buf bytes.Buffer
+	if err := fitted.RenderPlain(&buf); err != nil {
+		t.Fatalf("RenderPlain() error = %v", err)
+	}
+
+	want := "Long     Delta\n" +
+		"Header\n" +
+		"\x1b[32ma     \x1b[0m   \x1b[31m-1   \x1b[0m\n" +
+		"\x1b[32mb     \x1b[0m   2\n"
+	if got := buf.String(); got != want {
+		t.Errorf("RenderPlain() got = \n%q, want \n%q", got, want)
+	}
+}
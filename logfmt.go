@@ -0,0 +1,129 @@
+package tablr
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// FromLogfmt creates a table from logfmt lines, such as
+//
+//	level=info msg="request done" dur=3ms
+//
+// read from r. Each line becomes a row, and each key a column. Columns are
+// ordered as they are first found, unless WithSortedColumns or
+// WithPreferredColumns is used, e.g. to put time, level and msg first. Keys
+// missing from a line result in empty cells, and keys without a value in
+// empty values. Blank lines are ignored.
+//
+// Quoted values may contain the escape sequences of Go string literals.
+// Errors include the line number on which they occurred.
+func FromLogfmt(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	var keys []string
+	var records []map[string]string
+	seen := make(map[string]bool)
+
+	br := bufio.NewReader(r)
+	for line := 1; !c.rowLimitReached(len(records)); line++ {
+		data, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			record := make(map[string]string)
+			parseErr := parseLogfmt(data, func(key, value string) {
+				if !seen[key] {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+				record[key] = value
+			})
+			if parseErr != nil {
+				return nil, fmt.Errorf("line %d: %w", line, parseErr)
+			}
+			records = append(records, record)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	return c.newKeyedTable(keys, records), nil
+}
+
+// parseLogfmt calls fn for each key/value pair of a logfmt line.
+func parseLogfmt(data []byte, fn func(key, value string)) error {
+	i := 0
+	for {
+		for i < len(data) && isLogfmtSpace(data[i]) {
+			i++
+		}
+		if i == len(data) {
+			return nil
+		}
+
+		start := i
+		for i < len(data) && isLogfmtKeyByte(data[i]) {
+			i++
+		}
+		if i == start {
+			return fmt.Errorf("unexpected %q at column %d", data[i], i+1)
+		}
+		key := string(data[start:i])
+
+		if i == len(data) || data[i] != '=' {
+			fn(key, "")
+			continue
+		}
+		i++
+
+		if i < len(data) && data[i] == '"' {
+			end, err := logfmtQuoteEnd(data, i)
+			if err != nil {
+				return fmt.Errorf("key %s: %w", key, err)
+			}
+			value, err := strconv.Unquote(string(data[i:end]))
+			if err != nil {
+				return fmt.Errorf("key %s: invalid quoted value", key)
+			}
+			fn(key, value)
+			i = end
+			continue
+		}
+
+		start = i
+		for i < len(data) && !isLogfmtSpace(data[i]) {
+			i++
+		}
+		fn(key, string(data[start:i]))
+	}
+}
+
+// logfmtQuoteEnd returns the offset just past the closing quote of the quoted
+// value starting at data[start].
+func logfmtQuoteEnd(data []byte, start int) (int, error) {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("unterminated quoted value")
+}
+
+// isLogfmtSpace reports whether b separates logfmt pairs.
+func isLogfmtSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+// isLogfmtKeyByte reports whether b may be part of a logfmt key.
+func isLogfmtKeyByte(b byte) bool {
+	return b > ' ' && b != '=' && b != '"' && b != 0x7f
+}
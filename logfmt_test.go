@@ -0,0 +1,108 @@
+package tablr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestFromLogfmt(t *testing.T) {
+	input := `time=2024-03-01T12:00:00Z level=info msg="request done" dur=3ms path=/api
+
+level=warn msg="slow \"query\"\tagain" time=2024-03-01T12:00:01Z db
+time=2024-03-01T12:00:02Z msg=unquoted level=error err="line 1\nline 2 é"
+`
+
+	tests := []struct {
+		name        string
+		input       string
+		options     []tablr.ImportOption
+		wantColumns []string
+		wantRows    [][]string
+		wantErr     bool
+	}{
+		{
+			name:        "Discovery order",
+			input:       input,
+			wantColumns: []string{"time", "level", "msg", "dur", "path", "db", "err"},
+			wantRows: [][]string{
+				{"2024-03-01T12:00:00Z", "info", "request done", "3ms", "/api", "", ""},
+				{"2024-03-01T12:00:01Z", "warn", "slow \"query\"\tagain", "", "", "", ""},
+				{"2024-03-01T12:00:02Z", "error", "unquoted", "", "", "", "line 1\nline 2 é"},
+			},
+		},
+		{
+			name:        "Preferred order",
+			input:       input,
+			options:     []tablr.ImportOption{tablr.WithPreferredColumns("level", "msg", "missing")},
+			wantColumns: []string{"level", "msg", "time", "dur", "path", "db", "err"},
+			wantRows: [][]string{
+				{"info", "request done", "2024-03-01T12:00:00Z", "3ms", "/api", "", ""},
+				{"warn", "slow \"query\"\tagain", "2024-03-01T12:00:01Z", "", "", "", ""},
+				{"error", "unquoted", "2024-03-01T12:00:02Z", "", "", "", "line 1\nline 2 é"},
+			},
+		},
+		{
+			name:  "Preferred order with sorted columns, allowed columns and row limit",
+			input: input,
+			options: []tablr.ImportOption{
+				tablr.WithPreferredColumns("time"),
+				tablr.WithSortedColumns(),
+				tablr.WithAllowedColumns("time", "msg", "level"),
+				tablr.WithRowLimit(2),
+			},
+			wantColumns: []string{"time", "level", "msg"},
+			wantRows: [][]string{
+				{"2024-03-01T12:00:00Z", "info", "request done"},
+				{"2024-03-01T12:00:01Z", "warn", "slow \"query\"\tagain"},
+			},
+		},
+		{
+			name:        "Empty values",
+			input:       `a= b="" c`,
+			wantColumns: []string{"a", "b", "c"},
+			wantRows:    [][]string{{"", "", ""}},
+		},
+		{
+			name:    "Unterminated quote",
+			input:   "level=info\nmsg=\"oops\n",
+			wantErr: true,
+		},
+		{
+			name:    "Missing key",
+			input:   "level=info =value\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid escape",
+			input:   `msg="bad \q"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromLogfmt(strings.NewReader(tt.input), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromLogfmt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromLogfmt() columns = %q, want %q", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromLogfmt() rows = %q, want %q", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestFromLogfmt_ErrorLine(t *testing.T) {
+	_, err := tablr.FromLogfmt(strings.NewReader("a=1\n\nb=\"2\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Errorf("FromLogfmt() error = %v, want error on line 3", err)
+	}
+}
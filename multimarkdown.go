@@ -0,0 +1,127 @@
+package tablr
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// RenderMultiMarkdown renders the table as a MultiMarkdown table to the given
+// writer.
+//
+// In addition to what Render supports, the header rows added using
+// AddHeaderRow are written above the column headers, cells spanning multiple
+// columns are written using MultiMarkdown's || syntax, and the caption and
+// label of the table are written as a [Caption][label] line above the table.
+func (t *Table) RenderMultiMarkdown(w io.Writer) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	widths := t.multiMarkdownWidths()
+	bw := bufio.NewWriter(w)
+
+	if t.caption != "" {
+		bw.WriteString("[" + escapeMultiMarkdownCaption(t.caption) + "]")
+		if t.label != "" {
+			bw.WriteString("[" + escapeMultiMarkdownCaption(t.label) + "]")
+		}
+		bw.WriteString("\n")
+	}
+
+	for i, row := range t.headerRows {
+		t.writeMultiMarkdownRow(bw, row, widths, t.headerAlignments, func(col int) int {
+			return t.colSpan(cellRef{header: true, row: i, col: col})
+		})
+	}
+	t.writeMultiMarkdownRow(bw, t.columns, widths, t.headerAlignments, func(int) int {
+		return 1
+	})
+
+	bw.WriteString(t.delimiterRow(widths))
+	bw.WriteString("\n")
+
+	for i, row := range t.rows {
+		t.writeMultiMarkdownRow(bw, row, widths, t.columnAlignments, func(col int) int {
+			return t.colSpan(cellRef{row: i, col: col})
+		})
+	}
+
+	return bw.Flush()
+}
+
+// multiMarkdownWidths returns the column widths, widened where the extra
+// header rows or spanning cells need more room. Columns in which a data row
+// has a spanning cell get their width from the cells that do not span, as
+// the spanning cells are included in the widths of the table.
+func (t *Table) multiMarkdownWidths() []int {
+	widths := make([]int, len(t.columns))
+	copy(widths, t.columnMinWidths)
+
+	for ref := range t.colSpans {
+		if !ref.header && ref.col < len(widths) {
+			widths[ref.col] = cellWidth(t.columns[ref.col])
+		}
+	}
+	for i, row := range t.rows {
+		for col, cell := range row {
+			if t.colSpan(cellRef{row: i, col: col}) == 1 {
+				widths[col] = max(widths[col], cellWidth(cell))
+			}
+		}
+	}
+
+	fit := func(row []string, spanOf func(col int) int) {
+		for col := 0; col < len(row); {
+			span := spanOf(col)
+			if need, have := cellWidth(row[col]), spannedWidth(widths, col, span); need > have {
+				widths[col+span-1] += need - have
+			}
+			col += span
+		}
+	}
+
+	for i, row := range t.headerRows {
+		fit(row, func(col int) int {
+			return t.colSpan(cellRef{header: true, row: i, col: col})
+		})
+	}
+	for i, row := range t.rows {
+		fit(row, func(col int) int {
+			return t.colSpan(cellRef{row: i, col: col})
+		})
+	}
+
+	return widths
+}
+
+// writeMultiMarkdownRow writes a row, ending each cell with as many pipes as
+// the number of columns it spans.
+func (t *Table) writeMultiMarkdownRow(w *bufio.Writer, row []string, widths []int, alignments []Alignment, spanOf func(col int) int) {
+	w.WriteString("|")
+	for col := 0; col < len(row); {
+		span := spanOf(col)
+		w.WriteString(" ")
+		w.WriteString(pad(escapePipes(row[col]), spannedWidth(widths, col, span), alignments[col]))
+		w.WriteString(" ")
+		w.WriteString(strings.Repeat("|", span))
+		col += span
+	}
+	w.WriteString("\n")
+}
+
+// spannedWidth returns the width available to a cell spanning span columns
+// starting at col. A spanning cell also gets the room of the padding and
+// pipes between the columns it covers, except for the pipes closing it.
+func spannedWidth(widths []int, col, span int) int {
+	width := 2 * (span - 1)
+	for _, w := range widths[col : col+span] {
+		width += w
+	}
+	return width
+}
+
+// escapeMultiMarkdownCaption escapes the square brackets of a caption or
+// label.
+func escapeMultiMarkdownCaption(s string) string {
+	return strings.NewReplacer("[", `\[`, "]", `\]`).Replace(s)
+}
@@ -0,0 +1,121 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderMultiMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		setup   func(t *testing.T, table *tablr.Table)
+		options []tablr.TableOption
+		want    string
+	}{
+		{
+			name:    "Plain table",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			options: []tablr.TableOption{tablr.WithAlignments(defaultAlignments)},
+			want: `| Name     | Age |     City |
+|:---------|:---:|---------:|
+| John Doe | 30  | New York |
+`,
+		},
+		{
+			name:    "Caption and label",
+			columns: []string{"Name", "Age"},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			options: []tablr.TableOption{tablr.WithCaption("People [2024]", "people")},
+			want: `[People \[2024\]][people]
+| Name     | Age |
+|----------|-----|
+| John Doe | 30  |
+`,
+		},
+		{
+			name:    "Header rows and spans",
+			columns: []string{"First Header", "Second Header", "Third Header"},
+			rows: [][]string{
+				{"Content", "*Long Cell*", ""},
+				{"Content", "**Cell**", "Cell"},
+				{"New section", "More", "Data"},
+				{"And more", "With an escaped '|'", ""},
+			},
+			setup: func(t *testing.T, table *tablr.Table) {
+				table.AddHeaderRow([]string{"", "Grouping"})
+				if err := table.SetHeaderColSpan(0, 1, 2); err != nil {
+					t.Fatalf("SetHeaderColSpan() error = %v", err)
+				}
+				if err := table.SetColSpan(0, 1, 2); err != nil {
+					t.Fatalf("SetColSpan() error = %v", err)
+				}
+				if err := table.SetColSpan(3, 1, 2); err != nil {
+					t.Fatalf("SetColSpan() error = %v", err)
+				}
+				table.SetCaption("Prototype table", "")
+			},
+			options: []tablr.TableOption{
+				tablr.WithAlignments([]tablr.Alignment{tablr.AlignDefault, tablr.AlignCenter, tablr.AlignRight}),
+			},
+			want: `[Prototype table]
+|              |          Grouping           ||
+| First Header | Second Header | Third Header |
+|--------------|:-------------:|-------------:|
+| Content      |         *Long Cell*         ||
+| Content      |   **Cell**    |         Cell |
+| New section  |     More      |         Data |
+| And more     |    With an escaped '\|'     ||
+`,
+		},
+		{
+			name:    "Wide spanning cell",
+			columns: []string{"A", "B"},
+			rows: [][]string{
+				{"a very long spanning cell", ""},
+				{"1", "2"},
+			},
+			setup: func(t *testing.T, table *tablr.Table) {
+				if err := table.SetColSpan(0, 0, 2); err != nil {
+					t.Fatalf("SetColSpan() error = %v", err)
+				}
+				table.AddHeaderRow([]string{"group header wider than both", ""})
+				if err := table.SetHeaderColSpan(0, 0, 2); err != nil {
+					t.Fatalf("SetHeaderColSpan() error = %v", err)
+				}
+			},
+			want: `| group header wider than both ||
+| A | B                         |
+|---|---------------------------|
+| a very long spanning cell    ||
+| 1 | 2                         |
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tt.options...)
+			table.AddRows(tt.rows)
+			if tt.setup != nil {
+				tt.setup(t, table)
+			}
+
+			var buf bytes.Buffer
+			if err := table.RenderMultiMarkdown(&buf); err != nil {
+				t.Fatalf("RenderMultiMarkdown() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderMultiMarkdown() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package tablr
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ODSOption represents an option for configuring OpenDocument spreadsheet
+// output.
+type ODSOption func(*odsConfig)
+
+type odsConfig struct {
+	sheetName string
+}
+
+// WithODSSheetName sets the name of the sheet. The same names as for XLSX are
+// allowed. The default is "Sheet1".
+func WithODSSheetName(name string) ODSOption {
+	return func(c *odsConfig) {
+		c.sheetName = name
+	}
+}
+
+const odsMimeType = "application/vnd.oasis.opendocument.spreadsheet"
+
+// odsManifest lists the files of the document.
+const odsManifest = xml.Header + `<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">` +
+	`<manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + odsMimeType + `"/>` +
+	`<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>` +
+	`</manifest:manifest>`
+
+// odsDateLayouts holds the layouts of the values stored as dates, and whether
+// they include a time of day.
+var odsDateLayouts = []struct {
+	layout  string
+	hasTime bool
+}{
+	{time.DateOnly, false},
+	{time.DateTime, true},
+	{"2006-01-02T15:04:05", true},
+	{time.RFC3339, true},
+}
+
+// odsCellStyles holds the prefixes of the cell styles written for each
+// alignment, by kind of cell, and the data style they use, if any.
+var odsCellStyles = []struct {
+	prefix, dataStyle string
+	bold              bool
+}{
+	{"ceH", "", true},
+	{"ce", "", false},
+	{"ceD", "N1", false},
+	{"ceT", "N2", false},
+}
+
+// RenderODS renders the table as an OpenDocument spreadsheet with a single
+// sheet to the given writer. The header row is bold and repeated on printed
+// pages. Cells holding numbers are stored as numbers, unless they have more
+// than 15 significant digits and would be rounded, and cells holding dates in
+// ISO 8601 format, optionally with a time, as dates.
+func (t *Table) RenderODS(w io.Writer, opts ...ODSOption) error {
+	c := &odsConfig{
+		sheetName: "Sheet1",
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if err := validateSheetName(c.sheetName); err != nil {
+		return err
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	zw := zip.NewWriter(w)
+
+	// The mimetype must be the first file, and stored uncompressed, so that
+	// the type of the document can be detected from its first bytes.
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(f, odsMimeType); err != nil {
+		return err
+	}
+
+	if err := writeZipFile(zw, "META-INF/manifest.xml", odsManifest); err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "content.xml", t.odsContent(c)); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// odsContent returns the content of the document.
+func (t *Table) odsContent(c *odsConfig) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<office:document-content` +
+		` xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"` +
+		` xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0"` +
+		` xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"` +
+		` xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"` +
+		` xmlns:number="urn:oasis:names:tc:opendocument:xmlns:datastyle:1.0"` +
+		` xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0"` +
+		` office:version="1.2">`)
+
+	sb.WriteString(`<office:automatic-styles>`)
+	sb.WriteString(`<number:date-style style:name="N1">` +
+		`<number:year number:style="long"/><number:text>-</number:text>` +
+		`<number:month number:style="long"/><number:text>-</number:text>` +
+		`<number:day number:style="long"/></number:date-style>`)
+	sb.WriteString(`<number:date-style style:name="N2">` +
+		`<number:year number:style="long"/><number:text>-</number:text>` +
+		`<number:month number:style="long"/><number:text>-</number:text>` +
+		`<number:day number:style="long"/><number:text> </number:text>` +
+		`<number:hours number:style="long"/><number:text>:</number:text>` +
+		`<number:minutes number:style="long"/><number:text>:</number:text>` +
+		`<number:seconds number:style="long"/></number:date-style>`)
+	for i, width := range t.columnMinWidths {
+		fmt.Fprintf(&sb, `<style:style style:name="co%d" style:family="table-column">`+
+			`<style:table-column-properties style:column-width="%scm"/></style:style>`,
+			i+1, strconv.FormatFloat(float64(width+2)*0.2, 'f', -1, 64))
+	}
+	for _, cs := range odsCellStyles {
+		for a := AlignDefault; a <= AlignRight; a++ {
+			fmt.Fprintf(&sb, `<style:style style:name="%s%d" style:family="table-cell"`, cs.prefix, a)
+			if cs.dataStyle != "" {
+				fmt.Fprintf(&sb, ` style:data-style-name="%s"`, cs.dataStyle)
+			}
+			sb.WriteString(`>`)
+			if h := odsAlignment(a); h != "" {
+				fmt.Fprintf(&sb, `<style:paragraph-properties fo:text-align="%s"/>`, h)
+			}
+			if cs.bold {
+				sb.WriteString(`<style:text-properties fo:font-weight="bold"/>`)
+			}
+			sb.WriteString(`</style:style>`)
+		}
+	}
+	sb.WriteString(`</office:automatic-styles>`)
+
+	fmt.Fprintf(&sb, `<office:body><office:spreadsheet><table:table table:name="%s">`, escapeXML(c.sheetName))
+	for i := range t.columns {
+		fmt.Fprintf(&sb, `<table:table-column table:style-name="co%d"/>`, i+1)
+	}
+
+	sb.WriteString(`<table:table-header-rows><table:table-row>`)
+	for i, col := range t.columns {
+		writeODSCell(&sb, fmt.Sprintf("ceH%d", t.headerAlignments[i]), "string", "", col)
+	}
+	sb.WriteString(`</table:table-row></table:table-header-rows>`)
+
+	for _, row := range t.rows {
+		sb.WriteString(`<table:table-row>`)
+		for i, cell := range row {
+			a := t.columnAlignments[i]
+			switch date, hasTime, ok := odsDate(cell); {
+			case cell == "":
+				fmt.Fprintf(&sb, `<table:table-cell table:style-name="ce%d"/>`, a)
+			case isSpreadsheetNumber(cell):
+				writeODSCell(&sb, fmt.Sprintf("ce%d", a), "float", ` office:value="`+cell+`"`, cell)
+			case ok && hasTime:
+				writeODSCell(&sb, fmt.Sprintf("ceT%d", a), "date", ` office:date-value="`+date+`"`, cell)
+			case ok:
+				writeODSCell(&sb, fmt.Sprintf("ceD%d", a), "date", ` office:date-value="`+date+`"`, cell)
+			default:
+				writeODSCell(&sb, fmt.Sprintf("ce%d", a), "string", "", cell)
+			}
+		}
+		sb.WriteString(`</table:table-row>`)
+	}
+
+	sb.WriteString(`</table:table></office:spreadsheet></office:body></office:document-content>`)
+	return sb.String()
+}
+
+// writeODSCell writes a cell of the given value type, with one paragraph per
+// line of text. The value attribute, if any, must include a leading space.
+func writeODSCell(sb *strings.Builder, style, valueType, value, text string) {
+	fmt.Fprintf(sb, `<table:table-cell table:style-name="%s" office:value-type="%s"%s>`, style, valueType, value)
+	for _, line := range cellLines(text) {
+		fmt.Fprintf(sb, `<text:p>%s</text:p>`, escapeXML(line))
+	}
+	sb.WriteString(`</table:table-cell>`)
+}
+
+// odsDate returns the date value of s and whether it includes a time of day,
+// if s is a date in one of the supported layouts. Times are stored as given,
+// without their time zone.
+func odsDate(s string) (value string, hasTime, ok bool) {
+	for _, l := range odsDateLayouts {
+		if d, err := time.Parse(l.layout, s); err == nil {
+			if l.hasTime {
+				return d.Format("2006-01-02T15:04:05"), true, true
+			}
+			return d.Format(time.DateOnly), false, true
+		}
+	}
+	return "", false, false
+}
+
+// odsAlignment returns the text alignment of a cell style, or an empty string
+// for the default, which aligns text left and numbers right.
+func odsAlignment(a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return "start"
+	case AlignCenter:
+		return "center"
+	case AlignRight:
+		return "end"
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+package tablr_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+type odsTestCell struct {
+	Style     string   `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 style-name,attr"`
+	ValueType string   `xml:"urn:oasis:names:tc:opendocument:xmlns:office:1.0 value-type,attr"`
+	Value     string   `xml:"urn:oasis:names:tc:opendocument:xmlns:office:1.0 value,attr"`
+	DateValue string   `xml:"urn:oasis:names:tc:opendocument:xmlns:office:1.0 date-value,attr"`
+	Text      []string `xml:"urn:oasis:names:tc:opendocument:xmlns:text:1.0 p"`
+}
+
+type odsTestRow struct {
+	Cells []odsTestCell `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 table-cell"`
+}
+
+type odsTestContent struct {
+	Styles []struct {
+		Name      string `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 name,attr"`
+		DataStyle string `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 data-style-name,attr"`
+		Column    struct {
+			Width string `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 column-width,attr"`
+		} `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 table-column-properties"`
+		Paragraph struct {
+			TextAlign string `xml:"urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0 text-align,attr"`
+		} `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 paragraph-properties"`
+		Text struct {
+			FontWeight string `xml:"urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0 font-weight,attr"`
+		} `xml:"urn:oasis:names:tc:opendocument:xmlns:style:1.0 text-properties"`
+	} `xml:"automatic-styles>style"`
+	Table struct {
+		Name    string       `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 name,attr"`
+		Columns []struct{}   `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 table-column"`
+		Header  odsTestRow   `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 table-header-rows>table-row"`
+		Rows    []odsTestRow `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 table-row"`
+	} `xml:"body>spreadsheet>table"`
+}
+
+func TestTable_RenderODS(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age", "Joined"}, tablr.WithAlignments(defaultAlignments))
+	table.AddRows([][]string{
+		{"John & Jane", "30", "2024-03-01"},
+		{"Line 1\nLine 2", "", "2024-03-01T12:30:00+02:00"},
+		{"Max", "007", "soon"},
+	})
+
+	var buf bytes.Buffer
+	if err := table.RenderODS(&buf, tablr.WithODSSheetName("People")); err != nil {
+		t.Fatalf("RenderODS() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	if len(zr.File) == 0 || zr.File[0].Name != "mimetype" || zr.File[0].Method != zip.Store {
+		t.Fatalf("RenderODS() first file is not an uncompressed mimetype")
+	}
+	if got := string(readZipFile(t, buf.Bytes(), "mimetype")); got != "application/vnd.oasis.opendocument.spreadsheet" {
+		t.Errorf("RenderODS() mimetype = %q", got)
+	}
+	if !bytes.Contains(readZipFile(t, buf.Bytes(), "META-INF/manifest.xml"), []byte(`manifest:full-path="content.xml"`)) {
+		t.Errorf("RenderODS() manifest does not list content.xml")
+	}
+
+	var content odsTestContent
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "content.xml"), &content); err != nil {
+		t.Fatalf("Unmarshal(content) error = %v", err)
+	}
+
+	if content.Table.Name != "People" {
+		t.Errorf("RenderODS() sheet name = %q, want People", content.Table.Name)
+	}
+	if len(content.Table.Columns) != 3 {
+		t.Errorf("RenderODS() columns = %d, want 3", len(content.Table.Columns))
+	}
+
+	styles := make(map[string]int)
+	for i, s := range content.Styles {
+		styles[s.Name] = i
+	}
+	for name, want := range map[string]string{"co1": "2.6cm", "co2": "1cm", "co3": "5.4cm"} {
+		if got := content.Styles[styles[name]].Column.Width; got != want {
+			t.Errorf("RenderODS() width of %s = %q, want %q", name, got, want)
+		}
+	}
+	for name, want := range map[string][3]string{
+		"ceH1": {"start", "bold", ""},
+		"ceH3": {"end", "bold", ""},
+		"ce0":  {"", "", ""},
+		"ce2":  {"center", "", ""},
+		"ceD3": {"end", "", "N1"},
+		"ceT3": {"end", "", "N2"},
+	} {
+		s := content.Styles[styles[name]]
+		if got := [3]string{s.Paragraph.TextAlign, s.Text.FontWeight, s.DataStyle}; got != want {
+			t.Errorf("RenderODS() style %s = %v, want %v", name, got, want)
+		}
+	}
+
+	type cell struct {
+		style, valueType, value string
+		text                    []string
+	}
+	toCells := func(row odsTestRow) []cell {
+		var cells []cell
+		for _, c := range row.Cells {
+			cells = append(cells, cell{c.Style, c.ValueType, c.Value + c.DateValue, c.Text})
+		}
+		return cells
+	}
+
+	want := [][]cell{
+		{{"ceH1", "string", "", []string{"Name"}}, {"ceH2", "string", "", []string{"Age"}}, {"ceH3", "string", "", []string{"Joined"}}},
+		{{"ce1", "string", "", []string{"John & Jane"}}, {"ce2", "float", "30", []string{"30"}}, {"ceD3", "date", "2024-03-01", []string{"2024-03-01"}}},
+		{{"ce1", "string", "", []string{"Line 1", "Line 2"}}, {"ce2", "", "", nil}, {"ceT3", "date", "2024-03-01T12:30:00", []string{"2024-03-01T12:30:00+02:00"}}},
+		{{"ce1", "string", "", []string{"Max"}}, {"ce2", "string", "", []string{"007"}}, {"ce3", "string", "", []string{"soon"}}},
+	}
+	got := [][]cell{toCells(content.Table.Header)}
+	for _, row := range content.Table.Rows {
+		got = append(got, toCells(row))
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RenderODS() rows = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if len(got[i]) != len(want[i]) {
+			t.Errorf("RenderODS() row %d = %+v, want %+v", i, got[i], want[i])
+			continue
+		}
+		for j := range want[i] {
+			g, w := got[i][j], want[i][j]
+			if g.style != w.style || g.valueType != w.valueType || g.value != w.value || !equalSlices(g.text, w.text) {
+				t.Errorf("RenderODS() cell %d,%d = %+v, want %+v", i, j, g, w)
+			}
+		}
+	}
+}
+
+func TestTable_RenderODS_InvalidSheetName(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name"})
+	if err := table.RenderODS(io.Discard, tablr.WithODSSheetName("a/b")); err == nil {
+		t.Error("RenderODS() error = nil, want error")
+	}
+}
+
+func TestTable_RenderODS_LongNumbers(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"ID"})
+	table.AddRows([][]string{
+		{"123456789012345"},
+		{"12345678901234567890"},
+	})
+
+	var buf bytes.Buffer
+	if err := table.RenderODS(&buf); err != nil {
+		t.Fatalf("RenderODS() error = %v", err)
+	}
+
+	var content odsTestContent
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "content.xml"), &content); err != nil {
+		t.Fatalf("Unmarshal(content) error = %v", err)
+	}
+	if len(content.Table.Rows) != 2 {
+		t.Fatalf("RenderODS() rows = %d, want 2", len(content.Table.Rows))
+	}
+
+	for i, want := range [][2]string{
+		{"float", "123456789012345"},
+		{"string", ""},
+	} {
+		c := content.Table.Rows[i].Cells[0]
+		if got := [2]string{c.ValueType, c.Value}; got != want {
+			t.Errorf("RenderODS() row %d = %v, want %v", i, got, want)
+		}
+	}
+}
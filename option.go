@@ -89,6 +89,15 @@ func WithMinColumnWidth(index, minWidth int) TableOption {
 	}
 }
 
+// WithCaption sets the caption of the table and the label used to reference
+// it.
+func WithCaption(caption, label string) TableOption {
+	return func(t *Table) {
+		t.caption = caption
+		t.label = label
+	}
+}
+
 type column struct {
 	headerAlignment Alignment
 	alignment       Alignment
@@ -0,0 +1,256 @@
+package tablr
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// OrgOption represents an option for configuring Org-mode output.
+type OrgOption func(*orgConfig)
+
+type orgConfig struct {
+	cookies bool
+}
+
+// WithOrgAlignmentCookies sets whether a row of <l>, <c> and <r> alignment
+// cookies is written below the header. The row is only written if at least one
+// column has a non-default alignment. Cookies are written by default.
+func WithOrgAlignmentCookies(cookies bool) OrgOption {
+	return func(c *orgConfig) {
+		c.cookies = cookies
+	}
+}
+
+// orgCookie matches an Org-mode alignment and width cookie, such as <r> or
+// <l10>.
+var orgCookie = regexp.MustCompile(`^<([lcr]?)(\d*)>$`)
+
+// orgPipeReplacer escapes pipes using the Org-mode \vert entity, as Org tables
+// have no other way of escaping them.
+var orgPipeReplacer = strings.NewReplacer("|", `\vert{}`)
+
+// RenderOrg renders the table as an Org-mode table to the given writer.
+func (t *Table) RenderOrg(w io.Writer, opts ...OrgOption) error {
+	c := &orgConfig{
+		cookies: true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	cookies := c.cookies && t.hasAlignments()
+
+	header := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		header[i] = orgPipeReplacer.Replace(col)
+	}
+	rows := make([][]string, len(t.rows))
+	for i, row := range t.rows {
+		rows[i] = make([]string, len(row))
+		for j, cell := range row {
+			rows[i][j] = orgPipeReplacer.Replace(cell)
+		}
+	}
+
+	widths := displayWidths(header, rows)
+	for i, width := range t.columnDisplayWidths() {
+		widths[i] = max(widths[i], width)
+		if cookies {
+			widths[i] = max(widths[i], 3)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+
+	writeOrgRow(bw, header, widths, t.headerAlignments)
+
+	hline := make([]string, len(widths))
+	for i, width := range widths {
+		hline[i] = strings.Repeat("-", width+2)
+	}
+	bw.WriteString("|" + strings.Join(hline, "+") + "|\n")
+
+	if cookies {
+		cells := make([]string, len(t.columnAlignments))
+		for i, a := range t.columnAlignments {
+			cells[i] = orgAlignmentCookie(a)
+		}
+		writeOrgRow(bw, cells, widths, t.columnAlignments)
+	}
+
+	for _, row := range rows {
+		writeOrgRow(bw, row, widths, t.columnAlignments)
+	}
+
+	return bw.Flush()
+}
+
+// hasAlignments reports whether any column has a non-default alignment.
+func (t *Table) hasAlignments() bool {
+	for _, a := range t.columnAlignments {
+		if a != AlignDefault {
+			return true
+		}
+	}
+	return false
+}
+
+// writeOrgRow writes a single table row.
+func writeOrgRow(w *bufio.Writer, row []string, widths []int, alignments []Alignment) {
+	for i, cell := range row {
+		w.WriteString("| ")
+		w.WriteString(padDisplay(cell, widths[i], alignments[i]))
+		w.WriteString(" ")
+	}
+	w.WriteString("|\n")
+}
+
+// orgAlignmentCookie returns the alignment cookie for the alignment, or an
+// empty string for AlignDefault.
+func orgAlignmentCookie(a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return "<l>"
+	case AlignCenter:
+		return "<c>"
+	case AlignRight:
+		return "<r>"
+	}
+	return ""
+}
+
+// FromOrg creates a table from the first Org-mode table read from r. Lines
+// before the table are ignored.
+//
+// The first row that does not hold only alignment cookies is used as the
+// header and the remaining rows as data. Horizontal rules are skipped, and a
+// row of alignment cookies, such as <l>, <c> and <r>, sets the alignment of the
+// columns.
+func FromOrg(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	var (
+		rows       [][]string
+		alignments []Alignment
+		inTable    bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "|") {
+			if inTable {
+				break
+			}
+			continue
+		}
+		inTable = true
+
+		if strings.HasPrefix(line, "|-") {
+			continue
+		}
+
+		cells := splitOrgRow(line)
+		if a, ok := parseOrgCookies(cells); ok {
+			alignments = a
+			continue
+		}
+		rows = append(rows, cells)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("no Org table found")
+	}
+
+	if alignments != nil {
+		alignments = append(alignments, make([]Alignment, max(0, len(rows[0])-len(alignments)))...)
+		alignments = alignments[:len(rows[0])]
+	}
+
+	return c.newTable(rows[0], rows[1:], alignments), nil
+}
+
+// splitOrgRow splits a table row into its unescaped cells.
+func splitOrgRow(line string) []string {
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+
+	cells := strings.Split(line, "|")
+	for i, cell := range cells {
+		cells[i] = orgUnescape(strings.TrimSpace(cell))
+	}
+
+	return cells
+}
+
+// parseOrgCookies returns the alignments of a row holding only alignment
+// cookies and empty cells. It returns false if the row holds anything else or
+// no cookies at all.
+func parseOrgCookies(cells []string) ([]Alignment, bool) {
+	alignments := make([]Alignment, len(cells))
+	found := false
+
+	for i, cell := range cells {
+		if cell == "" {
+			continue
+		}
+		m := orgCookie.FindStringSubmatch(cell)
+		if m == nil {
+			return nil, false
+		}
+		found = true
+		switch m[1] {
+		case "l":
+			alignments[i] = AlignLeft
+		case "c":
+			alignments[i] = AlignCenter
+		case "r":
+			alignments[i] = AlignRight
+		}
+	}
+
+	return alignments, found
+}
+
+// orgUnescape reverses orgPipeReplacer. Like Org-mode, it also accepts the
+// \vert entity without braces, as long as it is not followed by a letter, so
+// that words such as \vertical are left alone.
+func orgUnescape(s string) string {
+	const entity = `\vert`
+
+	var sb strings.Builder
+	for {
+		i := strings.Index(s, entity)
+		if i < 0 {
+			break
+		}
+		rest := s[i+len(entity):]
+		r, _ := utf8.DecodeRuneInString(rest)
+		switch {
+		case strings.HasPrefix(rest, "{}"):
+			sb.WriteString(s[:i] + "|")
+			s = rest[2:]
+		case !unicode.IsLetter(r):
+			sb.WriteString(s[:i] + "|")
+			s = rest
+		default:
+			sb.WriteString(s[:i+len(entity)])
+			s = rest
+		}
+	}
+	sb.WriteString(s)
+
+	return sb.String()
+}
@@ -0,0 +1,244 @@
+package tablr_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderOrg(t *testing.T) {
+	tests := []struct {
+		name       string
+		columns    []string
+		rows       [][]string
+		alignments []tablr.Alignment
+		options    []tablr.OrgOption
+		want       string
+	}{
+		{
+			name:       "Alignment cookies",
+			columns:    []string{"Name", "Age", "City"},
+			alignments: defaultAlignments,
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `| Name       | Age |        City |
+|------------+-----+-------------|
+| <l>        | <c> |         <r> |
+| John Doe   | 30  |    New York |
+| Jane Smith | 25  | Los Angeles |
+`,
+		},
+		{
+			name:       "Cookies widen narrow columns",
+			columns:    []string{"A", "B"},
+			alignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignRight},
+			rows: [][]string{
+				{"x", "1"},
+			},
+			want: `| A   |   B |
+|-----+-----|
+|     | <r> |
+| x   |   1 |
+`,
+		},
+		{
+			name:       "Non-ASCII and wide characters",
+			columns:    []string{"Name", "City"},
+			alignments: []tablr.Alignment{tablr.AlignLeft, tablr.AlignRight},
+			rows: [][]string{
+				{"café", "東京"},
+				{"cafe", "Oslo"},
+			},
+			want: `| Name | City |
+|------+------|
+| <l>  |  <r> |
+| café | 東京 |
+| cafe | Oslo |
+`,
+		},
+		{
+			name:    "Default alignments have no cookies",
+			columns: []string{"Name", "Age"},
+			rows: [][]string{
+				{"John | Doe", "30"},
+			},
+			want: `| Name             | Age |
+|------------------+-----|
+| John \vert{} Doe | 30  |
+`,
+		},
+		{
+			name:       "Cookies disabled",
+			columns:    []string{"Name", "Age", "City"},
+			alignments: defaultAlignments,
+			options:    []tablr.OrgOption{tablr.WithOrgAlignmentCookies(false)},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+			},
+			want: `| Name     | Age |     City |
+|----------+-----+----------|
+| John Doe | 30  | New York |
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(tt.alignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			if err := table.RenderOrg(&buf, tt.options...); err != nil {
+				t.Fatalf("RenderOrg() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderOrg() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderOrg_MinColumnWidth(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "City"})
+	table.AddRows([][]string{
+		{"café", "東京"},
+	})
+	if err := table.SetColumnMinWidth(0, 8); err != nil {
+		t.Fatalf("SetColumnMinWidth() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := table.RenderOrg(&buf); err != nil {
+		t.Fatalf("RenderOrg() error = %v", err)
+	}
+
+	want := `| Name     | City |
+|----------+------|
+| café     | 東京 |
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderOrg() got = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestFromOrg(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		wantColumns    []string
+		wantRows       [][]string
+		wantAlignments []tablr.Alignment
+		wantErr        bool
+	}{
+		{
+			name: "Table with hline and cookies",
+			input: `* Notes
+Some text before the table.
+
+  | Name     | Age |   City |
+  |----------+-----+--------|
+  | <l>      | <c> |   <r10> |
+  | John Doe |  30 | New York |
+  | Jane \vert{} Smith | 25 |
+  |----------+-----+--------|
+
+| Another | table |
+`,
+			wantColumns: []string{"Name", "Age", "City"},
+			wantRows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane | Smith", "25", ""},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignLeft, tablr.AlignCenter, tablr.AlignRight},
+		},
+		{
+			name: "Table without hline",
+			input: `| a | b |
+| 1 | 2 |`,
+			wantColumns: []string{"a", "b"},
+			wantRows: [][]string{
+				{"1", "2"},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name: "Width cookies only",
+			input: `| <10> |     |
+| a    | b   |`,
+			wantColumns:    []string{"a", "b"},
+			wantRows:       [][]string{},
+			wantAlignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name: "Pipe entities",
+			input: `| Text |
+|------|
+| \vertical \vert{}a\vert b \vert\vert |`,
+			wantColumns: []string{"Text"},
+			wantRows: [][]string{
+				{`\vertical |a| b ||`},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignDefault},
+		},
+		{
+			name:    "No table",
+			input:   "just text\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromOrg(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromOrg() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromOrg() columns = %v, want %v", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromOrg() rows = %v, want %v", got, tt.wantRows)
+			}
+			if got := table.GetAlignments(); !equalSlices(got, tt.wantAlignments) {
+				t.Errorf("FromOrg() alignments = %v, want %v", got, tt.wantAlignments)
+			}
+		})
+	}
+}
+
+func TestFromOrg_RoundTrip(t *testing.T) {
+	table := newTable()
+	table.AddRows([][]string{
+		{"John | Doe", "30", "New York"},
+		{"Jane Smith", "25", ""},
+	})
+
+	var org bytes.Buffer
+	if err := table.RenderOrg(&org); err != nil {
+		t.Fatalf("RenderOrg() error = %v", err)
+	}
+
+	got, err := tablr.FromOrg(&org, tablr.WithWriter(&bytes.Buffer{}))
+	if err != nil {
+		t.Fatalf("FromOrg() error = %v", err)
+	}
+	if !equalSlices(got.GetColumns(), table.GetColumns()) {
+		t.Errorf("columns = %v, want %v", got.GetColumns(), table.GetColumns())
+	}
+	if !equalRows(got.GetRows(), table.GetRows()) {
+		t.Errorf("rows = %v, want %v", got.GetRows(), table.GetRows())
+	}
+	if !equalSlices(got.GetAlignments(), table.GetAlignments()) {
+		t.Errorf("alignments = %v, want %v", got.GetAlignments(), table.GetAlignments())
+	}
+	if got.String() != table.String() {
+		t.Errorf("Markdown = \n%v, want \n%v", got.String(), table.String())
+	}
+}
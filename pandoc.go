@@ -0,0 +1,205 @@
+package tablr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PandocStyle represents the style of a Pandoc Markdown table.
+type PandocStyle uint8
+
+const (
+	// PandocGrid renders a grid table with +---+ borders and a +===+ header
+	// separator holding the alignment markers.
+	PandocGrid PandocStyle = iota
+	// PandocMultiline renders a multiline table, where the alignment is given
+	// by the position of the headers relative to the dashed line below them.
+	PandocMultiline
+)
+
+// PandocOption represents an option for configuring Pandoc output.
+type PandocOption func(*pandocConfig)
+
+type pandocConfig struct {
+	style PandocStyle
+}
+
+// WithPandocStyle sets the table style. The default is PandocGrid.
+func WithPandocStyle(style PandocStyle) PandocOption {
+	return func(c *pandocConfig) {
+		c.style = style
+	}
+}
+
+// RenderPandoc renders the table as a Pandoc Markdown table to the given
+// writer. Cells may contain line breaks, which are rendered as lines of a
+// single multi-line cell. Cell values are written as-is, so they may hold
+// Markdown such as lists or paragraphs. In multiline tables, a blank line ends
+// a row, so an error is returned, and nothing is written, if a row would
+// contain one, e.g. for a cell holding paragraphs; use PandocGrid for those.
+func (t *Table) RenderPandoc(w io.Writer, opts ...PandocOption) error {
+	c := &pandocConfig{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	switch c.style {
+	case PandocGrid:
+		return t.renderPandocGrid(w)
+	case PandocMultiline:
+		if err := t.validatePandocMultiline(); err != nil {
+			return err
+		}
+		return t.renderPandocMultiline(w)
+	}
+
+	return fmt.Errorf("invalid Pandoc style: %d", c.style)
+}
+
+// renderPandocGrid writes the table as a grid table.
+func (t *Table) renderPandocGrid(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	widths := t.columnDisplayWidths()
+
+	border := rstBorder(widths, "+", '-', "+", "+")
+	bw.WriteString(border)
+	writeRSTGridRow(bw, t.columns, widths, t.headerAlignments)
+
+	separator := make([]string, len(t.columns))
+	for i, a := range t.columnAlignments {
+		separator[i] = pandocSeparator(widths[i]+2, a)
+	}
+	bw.WriteString("+" + strings.Join(separator, "+") + "+\n")
+
+	for _, row := range t.rows {
+		writeRSTGridRow(bw, row, widths, t.columnAlignments)
+		bw.WriteString(border)
+	}
+
+	return bw.Flush()
+}
+
+// pandocSeparator returns the part of the header separator of a grid table
+// for a single column, with colons marking the alignment.
+func pandocSeparator(width int, a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return ":" + strings.Repeat("=", width-1)
+	case AlignCenter:
+		return ":" + strings.Repeat("=", width-2) + ":"
+	case AlignRight:
+		return strings.Repeat("=", width-1) + ":"
+	}
+	return strings.Repeat("=", width)
+}
+
+// validatePandocMultiline checks that no line of the table is blank, as a
+// blank line ends a row of a multiline table.
+func (t *Table) validatePandocMultiline() error {
+	if line := pandocBlankLine(t.columns); line >= 0 {
+		return fmt.Errorf("header, line %d: multiline tables do not support blank lines", line)
+	}
+	for i, row := range t.rows {
+		if line := pandocBlankLine(row); line >= 0 {
+			return fmt.Errorf("row %d, line %d: multiline tables do not support blank lines", i, line)
+		}
+	}
+	return nil
+}
+
+// pandocBlankLine returns the index of the first line of the row on which all
+// cells are blank, or -1 if there is none.
+func pandocBlankLine(row []string) int {
+	lines := make([][]string, len(row))
+	height := 1
+	for i, cell := range row {
+		lines[i] = cellLines(cell)
+		height = max(height, len(lines[i]))
+	}
+
+	for line := range height {
+		blank := true
+		for i := range row {
+			if line < len(lines[i]) && strings.TrimSpace(lines[i][line]) != "" {
+				blank = false
+				break
+			}
+		}
+		if blank {
+			return line
+		}
+	}
+	return -1
+}
+
+// renderPandocMultiline writes the table as a multiline table. Pandoc derives
+// the alignment of a column from where its header sits relative to the dashed
+// line below it, so columns are widened where needed to make room for that.
+// Headers of columns using AlignDefault are placed flush left.
+func (t *Table) renderPandocMultiline(w io.Writer) error {
+	widths := t.columnDisplayWidths()
+	for i, col := range t.columns {
+		headerWidth := displayWidth(col)
+		switch t.columnAlignments[i] {
+		case AlignLeft, AlignRight:
+			widths[i] = max(widths[i], headerWidth+1)
+		case AlignCenter:
+			widths[i] = max(widths[i], headerWidth+2)
+		}
+	}
+
+	total := len(widths) - 1
+	underline := make([]string, len(widths))
+	for i, width := range widths {
+		total += width
+		underline[i] = strings.Repeat("-", width)
+	}
+	rule := strings.Repeat("-", max(total, 0)) + "\n"
+
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(rule)
+	writePandocMultilineRow(bw, t.columns, widths, t.columnAlignments)
+	bw.WriteString(strings.Join(underline, " ") + "\n")
+
+	for i, row := range t.rows {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		writePandocMultilineRow(bw, row, widths, t.columnAlignments)
+	}
+
+	bw.WriteString(rule)
+
+	return bw.Flush()
+}
+
+// writePandocMultilineRow writes a row of a multiline table, which spans as
+// many lines as the cell with the most lines.
+func writePandocMultilineRow(w *bufio.Writer, row []string, widths []int, alignments []Alignment) {
+	lines := make([][]string, len(row))
+	height := 1
+	for i, cell := range row {
+		lines[i] = cellLines(cell)
+		height = max(height, len(lines[i]))
+	}
+
+	for line := range height {
+		cells := make([]string, len(row))
+		for i := range row {
+			var text string
+			if line < len(lines[i]) {
+				text = lines[i][line]
+			}
+			cells[i] = padDisplay(text, widths[i], alignments[i])
+		}
+		w.WriteString(strings.TrimRight(strings.Join(cells, " "), " "))
+		w.WriteString("\n")
+	}
+}
@@ -0,0 +1,200 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderPandoc(t *testing.T) {
+	tests := []struct {
+		name       string
+		columns    []string
+		rows       [][]string
+		alignments []tablr.Alignment
+		options    []tablr.PandocOption
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "Grid table",
+			columns:    []string{"Fruit", "Price", "Advantages"},
+			alignments: []tablr.Alignment{tablr.AlignLeft, tablr.AlignRight, tablr.AlignCenter},
+			rows: [][]string{
+				{"Bananas", "$1.34", "- built-in wrapper\n- bright color"},
+				{"Oranges", "$2.10", "- cures scurvy\n- tasty"},
+			},
+			want: `+---------+-------+--------------------+
+| Fruit   | Price |     Advantages     |
++:========+======:+:==================:+
+| Bananas | $1.34 | - built-in wrapper |
+|         |       | - bright color     |
++---------+-------+--------------------+
+| Oranges | $2.10 | - cures scurvy     |
+|         |       | - tasty            |
++---------+-------+--------------------+
+`,
+		},
+		{
+			name:    "Grid table with default alignment",
+			columns: []string{"A", "B"},
+			rows: [][]string{
+				{"1", "2"},
+			},
+			want: `+---+---+
+| A | B |
++===+===+
+| 1 | 2 |
++---+---+
+`,
+		},
+		{
+			name:       "Multiline table",
+			columns:    []string{"Centered\nHeader", "Default", "Right\nAligned", "Left"},
+			alignments: []tablr.Alignment{tablr.AlignCenter, tablr.AlignDefault, tablr.AlignRight, tablr.AlignLeft},
+			options:    []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocMultiline)},
+			rows: [][]string{
+				{"First", "row", "12.0", "Example of a row that\nspans multiple lines."},
+				{"Second", "row", "5.0", "Here's another one."},
+			},
+			want: `-------------------------------------------------
+ Centered  Default    Right Left
+  Header            Aligned
+---------- ------- -------- ---------------------
+  First    row         12.0 Example of a row that
+                            spans multiple lines.
+
+  Second   row          5.0 Here's another one.
+-------------------------------------------------
+`,
+		},
+		{
+			name:    "Grid table with non-ASCII and wide characters",
+			columns: []string{"A", "B"},
+			rows: [][]string{
+				{"café", "東京"},
+			},
+			want: `+------+------+
+| A    | B    |
++======+======+
+| café | 東京 |
++------+------+
+`,
+		},
+		{
+			name:       "Multiline table with non-ASCII and wide characters",
+			columns:    []string{"Name", "City"},
+			alignments: []tablr.Alignment{tablr.AlignLeft, tablr.AlignRight},
+			options:    []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocMultiline)},
+			rows: [][]string{
+				{"café", "東京"},
+			},
+			want: `-----------
+Name   City
+----- -----
+café   東京
+-----------
+`,
+		},
+		{
+			name:    "Grid table with paragraphs",
+			columns: []string{"A"},
+			rows: [][]string{
+				{"para1\n\npara2"},
+			},
+			want: `+-------+
+| A     |
++=======+
+| para1 |
+|       |
+| para2 |
++-------+
+`,
+		},
+		{
+			name:    "Multiline table with paragraphs",
+			columns: []string{"A", "B"},
+			options: []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocMultiline)},
+			rows: [][]string{
+				{"para1\n\npara2", "x"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Multiline table with empty row",
+			columns: []string{"A", "B"},
+			options: []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocMultiline)},
+			rows: [][]string{
+				{"", ""},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Multiline table with blank line in one cell",
+			columns: []string{"A", "B"},
+			options: []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocMultiline)},
+			rows: [][]string{
+				{"one\n\nthree", "1\n2\n3"},
+			},
+			want: `-------
+A     B
+----- -
+one   1
+      2
+three 3
+-------
+`,
+		},
+		{
+			name:    "Invalid style",
+			columns: []string{"A"},
+			options: []tablr.PandocOption{tablr.WithPandocStyle(tablr.PandocStyle(9))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(tt.alignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderPandoc(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderPandoc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderPandoc() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderPandoc_MinColumnWidth(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"A", "B"})
+	table.AddRows([][]string{
+		{"café", "東京"},
+	})
+	if err := table.SetColumnMinWidth(1, 8); err != nil {
+		t.Fatalf("SetColumnMinWidth() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := table.RenderPandoc(&buf); err != nil {
+		t.Fatalf("RenderPandoc() error = %v", err)
+	}
+
+	want := `+------+----------+
+| A    | B        |
++======+==========+
+| café | 東京     |
++------+----------+
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderPandoc() got = \n%v, want \n%v", got, want)
+	}
+}
@@ -0,0 +1,109 @@
+package tablr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PlainOption represents an option for configuring plain text output.
+type PlainOption func(*plainConfig)
+
+type plainConfig struct {
+	gap              int
+	uppercaseHeaders bool
+}
+
+// WithPlainGap sets the number of spaces between columns. The default is 3.
+func WithPlainGap(gap int) PlainOption {
+	return func(c *plainConfig) {
+		c.gap = gap
+	}
+}
+
+// WithPlainUppercaseHeaders sets whether the headers are written in upper
+// case, e.g. NAME instead of Name.
+func WithPlainUppercaseHeaders(uppercase bool) PlainOption {
+	return func(c *plainConfig) {
+		c.uppercaseHeaders = uppercase
+	}
+}
+
+// RenderPlain renders the table as borderless, column-aligned text to the
+// given writer, in the style of command line tools such as kubectl and
+// docker:
+//
+//	NAME    STATUS    AGE
+//	web-1   Running   3d
+//
+// Columns are measured by the number of terminal columns their content takes
+// up, so wide characters such as CJK ideographs and emoji line up. Trailing
+// spaces are not written, except as part of a colored cell.
+func (t *Table) RenderPlain(w io.Writer, opts ...PlainOption) error {
+	c := &plainConfig{
+		gap: 3,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.gap < 0 {
+		return fmt.Errorf("invalid gap: %d", c.gap)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	header := t.columns
+	if c.uppercaseHeaders {
+		header = make([]string, len(t.columns))
+		for i, col := range t.columns {
+			header[i] = strings.ToUpper(col)
+		}
+	}
+
+	widths := displayWidths(header, t.rows)
+
+	bw := bufio.NewWriter(w)
+	gap := strings.Repeat(" ", c.gap)
+	p := t.painter(w)
+
+	writePlainRow(bw, p, header, widths, t.headerAlignments, gap, func(int, string) Style {
+		return p.theme.Header
+	})
+	for i, row := range t.rows {
+		writePlainRow(bw, p, row, widths, t.columnAlignments, gap, func(col int, value string) Style {
+			return p.cellStyle(t.columns, i, col, value)
+		})
+	}
+
+	return bw.Flush()
+}
+
+// writePlainRow writes a row, which spans as many lines as the cell with the
+// most lines. The style of each cell is given by styleOf.
+func writePlainRow(w *bufio.Writer, p painter, row []string, widths []int, alignments []Alignment, gap string, styleOf func(col int, value string) Style) {
+	lines := make([][]string, len(row))
+	styles := make([]Style, len(row))
+	height := 1
+	for i, cell := range row {
+		lines[i] = cellLines(cell)
+		styles[i] = styleOf(i, cell)
+		height = max(height, len(lines[i]))
+	}
+
+	for line := range height {
+		cells := make([]string, len(row))
+		for i := range row {
+			var text string
+			if line < len(lines[i]) {
+				text = lines[i][line]
+			}
+			cells[i] = p.paint(padDisplay(text, widths[i], alignments[i]), styles[i])
+		}
+		w.WriteString(strings.TrimRight(strings.Join(cells, gap), " "))
+		w.WriteString("\n")
+	}
+}
@@ -0,0 +1,94 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderPlain(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.PlainOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Default gap",
+			columns: []string{"Name", "Status", "Restarts"},
+			rows: [][]string{
+				{"web-1", "Running", "0"},
+				{"worker-12", "CrashLoopBackOff", "14"},
+			},
+			want: `Name        Status             Restarts
+web-1       Running                   0
+worker-12   CrashLoopBackOff         14
+`,
+		},
+		{
+			name:    "Uppercase headers and gap",
+			columns: []string{"Name", "Status", "Restarts"},
+			rows: [][]string{
+				{"web-1", "Running", "0"},
+			},
+			options: []tablr.PlainOption{
+				tablr.WithPlainUppercaseHeaders(true),
+				tablr.WithPlainGap(1),
+			},
+			want: `NAME  STATUS  RESTARTS
+web-1 Running        0
+`,
+		},
+		{
+			name:    "Wide characters",
+			columns: []string{"Name", "Status", "Restarts"},
+			rows: [][]string{
+				{"日本語", "🚀 ok", "1"},
+				{"café", "pending", "22"},
+			},
+			want: `Name     Status    Restarts
+日本語   🚀 ok            1
+café     pending         22
+`,
+		},
+		{
+			name:    "Multi-line cells",
+			columns: []string{"Name", "Status", "Restarts"},
+			rows: [][]string{
+				{"web-1", "Running\nReady", "0"},
+			},
+			want: `Name    Status    Restarts
+web-1   Running          0
+        Ready
+`,
+		},
+		{
+			name:    "Invalid gap",
+			columns: []string{"Name"},
+			options: []tablr.PlainOption{tablr.WithPlainGap(-1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignment(2, tablr.AlignRight))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderPlain(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderPlain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderPlain() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package tablr
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FromPrometheus creates a table from metrics in the Prometheus text
+// exposition format, such as a snapshot of a /metrics endpoint, read from r.
+// Each sample becomes a row holding the metric name, in the "metric" column,
+// one column per label found across all samples, and the value, in the
+// "value" column. Label columns are ordered as they are first found, unless
+// WithSortedColumns or WithPreferredColumns is used, and are empty for samples
+// without the label. A label column that would have the same name as another
+// column, such as a label named "value", is prefixed with "label_". Comments,
+// including HELP and TYPE lines, and timestamps are ignored.
+//
+// Samples can be filtered by metric name using WithMetricPrefix. With
+// WithPivotLabel, each value of the given label, such as the quantile of a
+// summary or the le of a histogram, becomes a column of its own, in the order
+// in which the values are first found, and samples that differ only in that
+// label share a row. The "value" column then only holds the values of samples
+// without the label, and is left out if there are none. A value column that
+// would have the same name as another column is prefixed with the label name
+// and "=", e.g. "type=value".
+//
+// Errors include the line number on which they occurred.
+func FromPrometheus(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	var labels []string
+	var samples []promSample
+	seen := make(map[string]bool)
+
+	br := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := br.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if text := strings.TrimSpace(data); text != "" && !strings.HasPrefix(text, "#") {
+			s, parseErr := parsePromSample(text)
+			if parseErr != nil {
+				return nil, fmt.Errorf("line %d: %w", line, parseErr)
+			}
+			if c.metricAllowed(s.name) {
+				for _, l := range s.labels {
+					if l.name != c.pivotLabel && !seen[l.name] {
+						seen[l.name] = true
+						labels = append(labels, l.name)
+					}
+				}
+				samples = append(samples, s)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	labels = c.orderColumns(labels)
+	if c.pivotLabel != "" {
+		return c.pivotPromSamples(samples, labels), nil
+	}
+
+	taken := map[string]bool{"metric": true, "value": true}
+	columns := []string{"metric"}
+	for _, l := range labels {
+		columns = append(columns, promColumn(l, "label_", taken))
+	}
+	columns = append(columns, "value")
+
+	rows := make([][]string, len(samples))
+	for i, s := range samples {
+		row := make([]string, 0, len(columns))
+		row = append(row, s.name)
+		for _, l := range labels {
+			row = append(row, s.label(l))
+		}
+		rows[i] = append(row, s.value)
+	}
+
+	return c.newTable(columns, rows, promAlignments(len(labels)+1, 1)), nil
+}
+
+// pivotPromSamples creates a table with a column for each value of the pivot
+// label, and a row for each metric and combination of the other labels.
+func (c *importConfig) pivotPromSamples(samples []promSample, labels []string) *Table {
+	var pivots []string
+	var rows [][]string
+	var values []map[string]string
+	var plain []string
+	hasPlain := false
+	index := make(map[string]int)
+
+	for _, s := range samples {
+		row := make([]string, 0, len(labels)+1)
+		row = append(row, s.name)
+		for _, l := range labels {
+			row = append(row, s.label(l))
+		}
+
+		key := fmt.Sprintf("%q", row)
+		i, ok := index[key]
+		if !ok {
+			i = len(rows)
+			index[key] = i
+			rows = append(rows, row)
+			values = append(values, make(map[string]string))
+			plain = append(plain, "")
+		}
+
+		pivot := s.label(c.pivotLabel)
+		if pivot == "" {
+			plain[i] = s.value
+			hasPlain = true
+			continue
+		}
+		if !slices.Contains(pivots, pivot) {
+			pivots = append(pivots, pivot)
+		}
+		values[i][pivot] = s.value
+	}
+
+	taken := map[string]bool{"metric": true, "value": hasPlain}
+	columns := []string{"metric"}
+	for _, l := range labels {
+		columns = append(columns, promColumn(l, "label_", taken))
+	}
+	if hasPlain {
+		columns = append(columns, "value")
+	}
+	for _, pivot := range pivots {
+		columns = append(columns, promColumn(pivot, c.pivotLabel+"=", taken))
+	}
+
+	for i, row := range rows {
+		if hasPlain {
+			row = append(row, plain[i])
+		}
+		for _, pivot := range pivots {
+			row = append(row, values[i][pivot])
+		}
+		rows[i] = row
+	}
+
+	return c.newTable(columns, rows, promAlignments(len(labels)+1, len(columns)-len(labels)-1))
+}
+
+// promColumn returns name, prefixed as many times as needed for it to differ
+// from the taken column names, and marks it as taken.
+func promColumn(name, prefix string, taken map[string]bool) string {
+	for taken[name] {
+		name = prefix + name
+	}
+	taken[name] = true
+	return name
+}
+
+// promAlignments returns the alignments of text columns followed by value
+// columns, which are aligned right.
+func promAlignments(text, values int) []Alignment {
+	alignments := make([]Alignment, text+values)
+	for i := text; i < len(alignments); i++ {
+		alignments[i] = AlignRight
+	}
+	return alignments
+}
+
+// metricAllowed reports whether the metric should be included in the table.
+func (c *importConfig) metricAllowed(name string) bool {
+	return c.metricPrefixes == nil || slices.ContainsFunc(c.metricPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// promSample represents a sample of the Prometheus text exposition format.
+type promSample struct {
+	name   string
+	labels []promLabel
+	value  string
+}
+
+// promLabel represents a label of a sample.
+type promLabel struct {
+	name, value string
+}
+
+// label returns the value of the label with the given name, or an empty
+// string if the sample does not have it.
+func (s promSample) label(name string) string {
+	for _, l := range s.labels {
+		if l.name == name {
+			return l.value
+		}
+	}
+	return ""
+}
+
+// parsePromSample parses a sample line, such as
+//
+//	http_requests_total{method="post",code="200"} 1027 1395066363000
+func parsePromSample(line string) (promSample, error) {
+	var s promSample
+
+	i := promName(line, 0)
+	if i == 0 {
+		return s, errors.New("invalid metric name")
+	}
+	s.name = line[:i]
+
+	if i < len(line) && line[i] == '{' {
+		labels, end, err := parsePromLabels(line, i+1)
+		if err != nil {
+			return s, err
+		}
+		s.labels = labels
+		i = end
+	}
+
+	fields := strings.Fields(line[i:])
+	if len(fields) == 0 || len(fields) > 2 {
+		return s, errors.New("expected a value and an optional timestamp")
+	}
+	if _, err := strconv.ParseFloat(fields[0], 64); err != nil {
+		return s, fmt.Errorf("invalid value %q", fields[0])
+	}
+	if len(fields) == 2 {
+		if _, err := strconv.ParseInt(fields[1], 10, 64); err != nil {
+			return s, fmt.Errorf("invalid timestamp %q", fields[1])
+		}
+	}
+	s.value = fields[0]
+
+	return s, nil
+}
+
+// parsePromLabels parses the labels of a sample, starting just after the
+// opening brace, and returns them along with the offset just past the closing
+// brace.
+func parsePromLabels(line string, i int) ([]promLabel, int, error) {
+	var labels []promLabel
+	for {
+		i = skipPromSpace(line, i)
+		if i == len(line) {
+			return nil, 0, errors.New("unterminated label set")
+		}
+		if line[i] == '}' {
+			return labels, i + 1, nil
+		}
+
+		end := promName(line, i)
+		if end == i {
+			return nil, 0, fmt.Errorf("invalid label name at column %d", i+1)
+		}
+		name := line[i:end]
+
+		i = skipPromSpace(line, end)
+		if i == len(line) || line[i] != '=' {
+			return nil, 0, fmt.Errorf("label %s: expected =", name)
+		}
+		i = skipPromSpace(line, i+1)
+		if i == len(line) || line[i] != '"' {
+			return nil, 0, fmt.Errorf("label %s: expected quoted value", name)
+		}
+		value, end, err := promLabelValue(line, i)
+		if err != nil {
+			return nil, 0, fmt.Errorf("label %s: %w", name, err)
+		}
+		if slices.ContainsFunc(labels, func(l promLabel) bool { return l.name == name }) {
+			return nil, 0, fmt.Errorf("duplicate label %s", name)
+		}
+		labels = append(labels, promLabel{name: name, value: value})
+
+		i = skipPromSpace(line, end)
+		if i < len(line) && line[i] == ',' {
+			i++
+		} else if i < len(line) && line[i] != '}' {
+			return nil, 0, fmt.Errorf("expected , or } at column %d", i+1)
+		}
+	}
+}
+
+// promLabelValue returns the value of the quoted label value starting at
+// line[start], along with the offset just past its closing quote.
+func promLabelValue(line string, start int) (string, int, error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 == len(line) {
+				return "", 0, errors.New("unterminated value")
+			}
+			i++
+			switch line[i] {
+			case '\\', '"':
+				sb.WriteByte(line[i])
+			case 'n':
+				sb.WriteByte('\n')
+			default:
+				return "", 0, fmt.Errorf("invalid escape \\%c", line[i])
+			}
+		default:
+			sb.WriteByte(line[i])
+		}
+	}
+	return "", 0, errors.New("unterminated value")
+}
+
+// promName returns the offset just past the metric or label name starting at
+// line[start], or start if there is none.
+func promName(line string, start int) int {
+	i := start
+	for i < len(line) {
+		b := line[i]
+		if b != '_' && b != ':' && !isASCIILetter(b) && (i == start || b < '0' || b > '9') {
+			break
+		}
+		i++
+	}
+	return i
+}
+
+// skipPromSpace returns the offset of the first byte at or after i that is not
+// a space or tab.
+func skipPromSpace(line string, i int) int {
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,197 @@
+package tablr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestFromPrometheus(t *testing.T) {
+	input := `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{ code = "400" , method="post", } 3
+
+# A comment.
+go_goroutines 42
+rpc_duration_seconds{quantile="0.5"} 4773
+rpc_duration_seconds{quantile="0.9", service="a\"b\\c\nd"} 9001
+rpc_duration_seconds{quantile="0.9"} 9.5e+03
+rpc_duration_seconds_sum 1.7560473e+07
+rpc_duration_seconds{} +Inf
+`
+
+	tests := []struct {
+		name           string
+		input          string
+		options        []tablr.ImportOption
+		wantColumns    []string
+		wantRows       [][]string
+		wantAlignments []tablr.Alignment
+		wantErr        bool
+	}{
+		{
+			name:        "Samples",
+			input:       input,
+			wantColumns: []string{"metric", "method", "code", "quantile", "service", "value"},
+			wantRows: [][]string{
+				{"http_requests_total", "post", "200", "", "", "1027"},
+				{"http_requests_total", "post", "400", "", "", "3"},
+				{"go_goroutines", "", "", "", "", "42"},
+				{"rpc_duration_seconds", "", "", "0.5", "", "4773"},
+				{"rpc_duration_seconds", "", "", "0.9", "a\"b\\c\nd", "9001"},
+				{"rpc_duration_seconds", "", "", "0.9", "", "9.5e+03"},
+				{"rpc_duration_seconds_sum", "", "", "", "", "1.7560473e+07"},
+				{"rpc_duration_seconds", "", "", "", "", "+Inf"},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault,
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight,
+			},
+		},
+		{
+			name:        "Metric prefixes with sorted columns",
+			input:       input,
+			options:     []tablr.ImportOption{tablr.WithMetricPrefix("http_", "go_"), tablr.WithSortedColumns()},
+			wantColumns: []string{"metric", "code", "method", "value"},
+			wantRows: [][]string{
+				{"http_requests_total", "200", "post", "1027"},
+				{"http_requests_total", "400", "post", "3"},
+				{"go_goroutines", "", "", "42"},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight,
+			},
+		},
+		{
+			name:        "Pivot label",
+			input:       input,
+			options:     []tablr.ImportOption{tablr.WithMetricPrefix("rpc_"), tablr.WithPivotLabel("quantile")},
+			wantColumns: []string{"metric", "service", "value", "0.5", "0.9"},
+			wantRows: [][]string{
+				{"rpc_duration_seconds", "", "+Inf", "4773", "9.5e+03"},
+				{"rpc_duration_seconds", "a\"b\\c\nd", "", "", "9001"},
+				{"rpc_duration_seconds_sum", "", "1.7560473e+07", "", ""},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight, tablr.AlignRight, tablr.AlignRight,
+			},
+		},
+		{
+			name: "Pivot label of a histogram",
+			input: `request_seconds_bucket{le="0.1",path="/"} 10
+request_seconds_bucket{le="1",path="/"} 15
+request_seconds_bucket{le="+Inf",path="/"} 16
+request_seconds_bucket{le="0.1",path="/api"} 1
+request_seconds_bucket{le="+Inf",path="/api"} 2
+`,
+			options:     []tablr.ImportOption{tablr.WithPivotLabel("le"), tablr.WithAllowedColumns("path", "0.1", "+Inf")},
+			wantColumns: []string{"path", "0.1", "+Inf"},
+			wantRows: [][]string{
+				{"/", "10", "16"},
+				{"/api", "1", "2"},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignRight, tablr.AlignRight},
+		},
+		{
+			name:        "Clashing label names",
+			input:       `m{value="x",metric="y",label_value="z"} 1`,
+			wantColumns: []string{"metric", "label_value", "label_metric", "label_label_value", "value"},
+			wantRows: [][]string{
+				{"m", "x", "y", "z", "1"},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight,
+			},
+		},
+		{
+			name: "Clashing pivot values",
+			input: `m{type="metric",value="v"} 1
+m{type="label_value",value="v"} 2
+m{value="v"} 3
+`,
+			options:     []tablr.ImportOption{tablr.WithPivotLabel("type")},
+			wantColumns: []string{"metric", "label_value", "value", "type=metric", "type=label_value"},
+			wantRows: [][]string{
+				{"m", "v", "3", "1", "2"},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignRight, tablr.AlignRight, tablr.AlignRight,
+			},
+		},
+		{
+			name:    "Invalid metric name",
+			input:   "1metric 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "Missing value",
+			input:   `metric{a="b"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid value",
+			input:   "metric one\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid timestamp",
+			input:   "metric 1 yesterday\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unquoted label value",
+			input:   "metric{a=b} 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unterminated label value",
+			input:   `metric{a="b} 1`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid escape",
+			input:   `metric{a="\t"} 1`,
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate label",
+			input:   `metric{a="1",a="2"} 1`,
+			wantErr: true,
+		},
+		{
+			name:    "Missing comma",
+			input:   `metric{a="1" b="2"} 1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromPrometheus(strings.NewReader(tt.input), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromPrometheus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromPrometheus() columns = %q, want %q", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromPrometheus() rows = %q, want %q", got, tt.wantRows)
+			}
+			if got := table.GetAlignments(); !equalSlices(got, tt.wantAlignments) {
+				t.Errorf("FromPrometheus() alignments = %v, want %v", got, tt.wantAlignments)
+			}
+		})
+	}
+}
+
+func TestFromPrometheus_ErrorLine(t *testing.T) {
+	_, err := tablr.FromPrometheus(strings.NewReader("# TYPE up gauge\nup 1\nup{job=} 0\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Errorf("FromPrometheus() error = %v, want error on line 3", err)
+	}
+}
@@ -10,38 +10,47 @@ func (t *Table) Render() {
 	// Write header column
 	for i, col := range t.columns {
 		fmt.Fprint(t.writer, "| ")
-		fmt.Fprint(t.writer, pad(col, t.columnMinWidths[i], t.headerAlignments[i]))
+		fmt.Fprint(t.writer, pad(escapePipes(col), t.columnMinWidths[i], t.headerAlignments[i]))
 		fmt.Fprint(t.writer, " ")
 	}
 	fmt.Fprintln(t.writer, "|")
 
 	// Write alignment row
-	for i, align := range t.columnAlignments {
-		fmt.Fprint(t.writer, "|")
-		switch align {
-		case AlignDefault:
-			fmt.Fprint(t.writer, "-", strings.Repeat("-", t.columnMinWidths[i]), "-")
-		case AlignLeft:
-			fmt.Fprint(t.writer, ":", strings.Repeat("-", t.columnMinWidths[i]), "-")
-		case AlignCenter:
-			fmt.Fprint(t.writer, ":", strings.Repeat("-", t.columnMinWidths[i]), ":")
-		case AlignRight:
-			fmt.Fprint(t.writer, "-", strings.Repeat("-", t.columnMinWidths[i]), ":")
-		}
-	}
-	fmt.Fprintln(t.writer, "|")
+	fmt.Fprintln(t.writer, t.delimiterRow(t.columnMinWidths))
 
 	// Write data rows
 	for _, row := range t.rows {
 		for i, cell := range row {
 			fmt.Fprint(t.writer, "| ")
-			fmt.Fprint(t.writer, pad(cell, t.columnMinWidths[i], t.columnAlignments[i]))
+			fmt.Fprint(t.writer, pad(escapePipes(cell), t.columnMinWidths[i], t.columnAlignments[i]))
 			fmt.Fprint(t.writer, " ")
 		}
 		fmt.Fprintln(t.writer, "|")
 	}
 }
 
+// delimiterRow returns the row separating the header from the data rows, with
+// colons marking the alignment of each column. The row has no trailing
+// newline.
+func (t *Table) delimiterRow(widths []int) string {
+	var sb strings.Builder
+	for i, align := range t.columnAlignments {
+		sb.WriteString("|")
+		switch align {
+		case AlignDefault:
+			sb.WriteString("-" + strings.Repeat("-", widths[i]) + "-")
+		case AlignLeft:
+			sb.WriteString(":" + strings.Repeat("-", widths[i]) + "-")
+		case AlignCenter:
+			sb.WriteString(":" + strings.Repeat("-", widths[i]) + ":")
+		case AlignRight:
+			sb.WriteString("-" + strings.Repeat("-", widths[i]) + ":")
+		}
+	}
+	sb.WriteString("|")
+	return sb.String()
+}
+
 // String returns the table as a string.
 func (t *Table) String() string {
 	var sb strings.Builder
@@ -0,0 +1,239 @@
+package tablr
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RSTStyle represents the style of a reStructuredText table.
+type RSTStyle uint8
+
+const (
+	// RSTGrid renders a grid table with +---+ borders. Grid tables support
+	// cells spanning multiple lines.
+	RSTGrid RSTStyle = iota
+	// RSTSimple renders a simple table with === rules.
+	RSTSimple
+)
+
+// RSTOption represents an option for configuring reStructuredText output.
+type RSTOption func(*rstConfig)
+
+type rstConfig struct {
+	style RSTStyle
+}
+
+// WithRSTStyle sets the table style. The default is RSTGrid.
+func WithRSTStyle(style RSTStyle) RSTOption {
+	return func(c *rstConfig) {
+		c.style = style
+	}
+}
+
+// RenderRST renders the table as a reStructuredText table to the given writer.
+// Columns are measured by display width, as docutils does, so that wide
+// characters keep the table aligned. An error is returned, and nothing is
+// written, if a cell cannot be represented in the chosen style.
+func (t *Table) RenderRST(w io.Writer, opts ...RSTOption) error {
+	c := &rstConfig{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.columns) == 0 {
+		return errors.New("table has no columns")
+	}
+
+	switch c.style {
+	case RSTGrid:
+		if err := t.validateRSTGrid(); err != nil {
+			return err
+		}
+		return t.renderRSTGrid(w)
+	case RSTSimple:
+		if err := t.validateRSTSimple(); err != nil {
+			return err
+		}
+		return t.renderRSTSimple(w)
+	}
+
+	return fmt.Errorf("invalid RST style: %d", c.style)
+}
+
+// renderRSTGrid writes the table as a grid table.
+func (t *Table) renderRSTGrid(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	widths := t.columnDisplayWidths()
+
+	border := rstBorder(widths, "+", '-', "+", "+")
+	bw.WriteString(border)
+	writeRSTGridRow(bw, t.columns, widths, t.headerAlignments)
+	bw.WriteString(rstBorder(widths, "+", '=', "+", "+"))
+
+	for _, row := range t.rows {
+		writeRSTGridRow(bw, row, widths, t.columnAlignments)
+		bw.WriteString(border)
+	}
+
+	return bw.Flush()
+}
+
+// writeRSTGridRow writes a grid table row, which spans as many lines as the
+// cell with the most lines. The lines of multi-line cells are written flush
+// left, as their content is parsed as markup, in which indentation matters.
+func writeRSTGridRow(w *bufio.Writer, row []string, widths []int, alignments []Alignment) {
+	lines := make([][]string, len(row))
+	height := 1
+	for i, cell := range row {
+		lines[i] = cellLines(cell)
+		height = max(height, len(lines[i]))
+	}
+
+	for line := range height {
+		for i := range row {
+			var text string
+			if line < len(lines[i]) {
+				text = lines[i][line]
+			}
+			align := alignments[i]
+			if len(lines[i]) > 1 {
+				align = AlignLeft
+			}
+			w.WriteString("| ")
+			w.WriteString(padDisplay(text, widths[i], align))
+			w.WriteString(" ")
+		}
+		w.WriteString("|\n")
+	}
+}
+
+// renderRSTSimple writes the table as a simple table.
+func (t *Table) renderRSTSimple(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	widths := t.columnDisplayWidths()
+
+	border := rstBorder(widths, "", '=', "  ", "")
+	bw.WriteString(border)
+	writeRSTSimpleRow(bw, t.columns, widths, t.headerAlignments)
+	bw.WriteString(border)
+
+	for _, row := range t.rows {
+		writeRSTSimpleRow(bw, row, widths, t.columnAlignments)
+	}
+	bw.WriteString(border)
+
+	return bw.Flush()
+}
+
+// writeRSTSimpleRow writes a simple table row without trailing whitespace.
+func writeRSTSimpleRow(w *bufio.Writer, row []string, widths []int, alignments []Alignment) {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = padDisplay(cell, widths[i], alignments[i])
+	}
+	w.WriteString(strings.TrimRight(strings.Join(cells, "  "), " "))
+	w.WriteString("\n")
+}
+
+// rstBorder returns a border line using fill for each column of the given
+// width.
+func rstBorder(widths []int, left string, fill byte, sep, right string) string {
+	extra := 0
+	if left != "" {
+		// Account for the space on either side of the cell content.
+		extra = 2
+	}
+
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		parts[i] = strings.Repeat(string(fill), width+extra)
+	}
+
+	return left + strings.Join(parts, sep) + right + "\n"
+}
+
+// validateRSTGrid checks that all cells can be represented in a grid table:
+// they must not contain tabs, lines of multi-line cells must not start with
+// whitespace, which would be read as indentation, and no line may start an
+// explicit markup block, whose content would be lost.
+func (t *Table) validateRSTGrid() error {
+	return t.validateRST(func(cell string) error {
+		if strings.Contains(cell, "\t") {
+			return errors.New("cell contains a tab character")
+		}
+		lines := cellLines(cell)
+		for _, line := range lines {
+			if len(lines) > 1 && strings.TrimLeft(line, " ") != line {
+				return fmt.Errorf("line %q starts with whitespace, which would be read as indentation", line)
+			}
+			if isRSTExplicitMarkup(line) {
+				return fmt.Errorf("line %q would be read as a comment or directive", line)
+			}
+		}
+		return nil
+	})
+}
+
+// validateRSTSimple checks that all cells can be represented in a simple
+// table.
+func (t *Table) validateRSTSimple() error {
+	if err := t.validateRST(func(cell string) error {
+		switch {
+		case strings.Contains(cell, "\t"):
+			return errors.New("cell contains a tab character")
+		case strings.ContainsAny(cell, "\r\n"):
+			return errors.New("simple tables do not support multi-line cells")
+		case cell != "" && strings.Trim(cell, "=-") == "":
+			return fmt.Errorf("cell %q would be read as a table rule", cell)
+		case isRSTExplicitMarkup(cell):
+			return fmt.Errorf("cell %q would be read as a comment or directive", cell)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// A blank first column marks a continuation line in simple tables.
+	for i, row := range t.rows {
+		if strings.TrimSpace(row[0]) == "" {
+			return fmt.Errorf("row %d, column 0: simple tables do not support an empty first column", i)
+		}
+	}
+
+	return nil
+}
+
+// validateRST calls check for the header and every cell, and returns the
+// first error with its position.
+func (t *Table) validateRST(check func(cell string) error) error {
+	for i, col := range t.columns {
+		if err := check(col); err != nil {
+			return fmt.Errorf("header, column %d: %w", i, err)
+		}
+	}
+
+	for i, row := range t.rows {
+		for j, cell := range row {
+			if err := check(cell); err != nil {
+				return fmt.Errorf("row %d, column %d: %w", i, j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isRSTExplicitMarkup reports whether line starts an explicit markup block,
+// such as a comment or directive, which starts with ".." followed by
+// whitespace or the end of the line.
+func isRSTExplicitMarkup(line string) bool {
+	rest, ok := strings.CutPrefix(line, "..")
+	return ok && (rest == "" || rest[0] == ' ')
+}
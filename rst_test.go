@@ -0,0 +1,233 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderRST(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.RSTOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Grid table",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `+------------+-----+-------------+
+| Name       | Age |        City |
++============+=====+=============+
+| John Doe   | 30  |    New York |
++------------+-----+-------------+
+| Jane Smith | 25  | Los Angeles |
++------------+-----+-------------+
+`,
+		},
+		{
+			name:    "Grid table with multi-line cells",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York\nUSA"},
+				{"Jane\nSmith", "25", "Paris"},
+			},
+			want: `+----------+-----+----------+
+| Name     | Age |     City |
++==========+=====+==========+
+| John Doe | 30  | New York |
+|          |     | USA      |
++----------+-----+----------+
+| Jane     | 25  |    Paris |
+| Smith    |     |          |
++----------+-----+----------+
+`,
+		},
+		{
+			name:    "Simple table",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			want: `==========  ===  ===========
+Name        Age         City
+==========  ===  ===========
+John Doe    30      New York
+Jane Smith  25   Los Angeles
+==========  ===  ===========
+`,
+		},
+		{
+			name:    "Grid table with non-ASCII and wide characters",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"café", "30", "東京"},
+				{"cafe", "25", "A|B"},
+			},
+			want: `+------+-----+------+
+| Name | Age | City |
++======+=====+======+
+| café | 30  | 東京 |
++------+-----+------+
+| cafe | 25  |  A|B |
++------+-----+------+
+`,
+		},
+		{
+			name:    "Simple table with non-ASCII and wide characters",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"café", "30", "東京"},
+				{"cafe", "25", "Oslo"},
+			},
+			want: `====  ===  ====
+Name  Age  City
+====  ===  ====
+café  30   東京
+cafe  25   Oslo
+====  ===  ====
+`,
+		},
+		{
+			name:    "Grid table with tab",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John\tDoe", "30", "New York"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Simple table with multi-line cell",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"John Doe", "30", "New York\nUSA"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Simple table with empty first column",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"", "30", "New York"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Simple table with rule-like cell",
+			columns: []string{"Name", "Age", "City"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"John Doe", "==", "New York"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid style",
+			columns: []string{"Name"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTStyle(9))},
+			wantErr: true,
+		},
+		{
+			name:    "Grid table with multi-line centered cell",
+			columns: []string{"Name", "Items"},
+			rows: [][]string{
+				{"a", "- a\n- longer item"},
+			},
+			want: `+------+---------------+
+| Name |     Items     |
++======+===============+
+| a    | - a           |
+|      | - longer item |
++------+---------------+
+`,
+		},
+		{
+			name:    "Grid table with indented line",
+			columns: []string{"Name"},
+			rows: [][]string{
+				{"a\n  b"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Grid table with comment-like cell",
+			columns: []string{"Name"},
+			rows: [][]string{
+				{".. note:: hidden"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Simple table with comment-like cell",
+			columns: []string{"Name", "Age"},
+			options: []tablr.RSTOption{tablr.WithRSTStyle(tablr.RSTSimple)},
+			rows: [][]string{
+				{"a", ".."},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "No columns",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(defaultAlignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderRST(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderRST() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if buf.Len() != 0 {
+					t.Errorf("RenderRST() wrote %q on error", buf.String())
+				}
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderRST() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderRST_MinColumnWidth(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "City"})
+	table.AddRows([][]string{
+		{"café", "東京"},
+	})
+	if err := table.SetColumnMinWidth(0, 12); err != nil {
+		t.Fatalf("SetColumnMinWidth() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := table.RenderRST(&buf); err != nil {
+		t.Fatalf("RenderRST() error = %v", err)
+	}
+
+	want := `+--------------+------+
+| Name         | City |
++==============+======+
+| café         | 東京 |
++--------------+------+
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderRST() got = \n%v, want \n%v", got, want)
+	}
+}
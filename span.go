@@ -0,0 +1,157 @@
+package tablr
+
+import "fmt"
+
+// cellRef identifies a cell in a data row or in one of the extra header rows.
+type cellRef struct {
+	header bool
+	row    int
+	col    int
+}
+
+// AddHeaderRow adds a header row above the column headers. This is useful for
+// grouping columns, in combination with SetHeaderColSpan. Renderers for
+// formats that do not support multiple header rows ignore these rows.
+// The row is padded or truncated to the number of columns.
+func (t *Table) AddHeaderRow(row []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.headerRows = append(t.headerRows, t.adjustRowLength(row))
+}
+
+// GetHeaderRows returns the header rows added using AddHeaderRow.
+func (t *Table) GetHeaderRows() [][]string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.headerRows
+}
+
+// SetColSpan makes the cell at the given row and column span the given number
+// of columns. The cells it covers are not rendered. A span of 1 removes the
+// span. Renderers for formats that do not support spans ignore them.
+func (t *Table) SetColSpan(row, col, span int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if row < 0 || row >= len(t.rows) {
+		return fmt.Errorf("row index out of range: %d, rows: %d", row, len(t.rows))
+	}
+
+	return t.setColSpan(cellRef{row: row, col: col}, span)
+}
+
+// SetHeaderColSpan makes the cell at the given column of a header row added
+// using AddHeaderRow span the given number of columns.
+func (t *Table) SetHeaderColSpan(headerRow, col, span int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if headerRow < 0 || headerRow >= len(t.headerRows) {
+		return fmt.Errorf("header row index out of range: %d, header rows: %d", headerRow, len(t.headerRows))
+	}
+
+	return t.setColSpan(cellRef{header: true, row: headerRow, col: col}, span)
+}
+
+// GetColSpan returns the number of columns spanned by the cell at the given
+// row and column. Cells without a span return 1.
+func (t *Table) GetColSpan(row, col int) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.colSpan(cellRef{row: row, col: col})
+}
+
+// setColSpan sets the span of a cell without locking.
+func (t *Table) setColSpan(ref cellRef, span int) error {
+	if ref.col < 0 || ref.col >= len(t.columns) {
+		return fmt.Errorf("column index out of range: %d, columns: %d", ref.col, len(t.columns))
+	}
+	if span < 1 || ref.col+span > len(t.columns) {
+		return fmt.Errorf("invalid column span: %d, columns available: %d", span, len(t.columns)-ref.col)
+	}
+
+	if span == 1 {
+		delete(t.colSpans, ref)
+		return nil
+	}
+
+	if t.colSpans == nil {
+		t.colSpans = make(map[cellRef]int)
+	}
+	t.colSpans[ref] = span
+
+	return nil
+}
+
+// colSpan returns the span of a cell, limited to the number of columns.
+func (t *Table) colSpan(ref cellRef) int {
+	span, ok := t.colSpans[ref]
+	if !ok {
+		return 1
+	}
+
+	return max(1, min(span, len(t.columns)-ref.col))
+}
+
+// deleteRowSpans removes the spans of the data row at the given index and
+// moves the spans of the rows below it up.
+func (t *Table) deleteRowSpans(index int) {
+	t.updateSpans(func(ref cellRef, span int) (cellRef, int) {
+		switch {
+		case ref.header || ref.row < index:
+			return ref, span
+		case ref.row == index:
+			return ref, 0
+		}
+		ref.row--
+		return ref, span
+	})
+}
+
+// deleteColumnSpans removes the spans starting at the column at the given
+// index, shrinks the spans covering it and moves the spans to the right of it
+// to the left.
+func (t *Table) deleteColumnSpans(index int) {
+	t.updateSpans(func(ref cellRef, span int) (cellRef, int) {
+		switch {
+		case ref.col == index:
+			return ref, 0
+		case ref.col > index:
+			ref.col--
+		case ref.col+span > index:
+			span--
+		}
+		return ref, span
+	})
+}
+
+// clearSpans removes the spans of all data rows, and of the header rows if
+// headers is true.
+func (t *Table) clearSpans(headers bool) {
+	t.updateSpans(func(ref cellRef, span int) (cellRef, int) {
+		if ref.header && !headers {
+			return ref, span
+		}
+		return ref, 0
+	})
+}
+
+// updateSpans replaces each span with the result of fn. Spans of less than 2
+// columns are removed.
+func (t *Table) updateSpans(fn func(ref cellRef, span int) (cellRef, int)) {
+	if len(t.colSpans) == 0 {
+		return
+	}
+
+	spans := make(map[cellRef]int, len(t.colSpans))
+	for ref, span := range t.colSpans {
+		if ref, span = fn(ref, span); span > 1 {
+			spans[ref] = span
+		}
+	}
+
+	t.colSpans = spans
+}
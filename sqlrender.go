@@ -0,0 +1,310 @@
+package tablr
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// SQLDialect represents the SQL dialect used by a SQLRenderer.
+type SQLDialect uint8
+
+const (
+	// DialectSQLite quotes identifiers using double quotes.
+	DialectSQLite SQLDialect = iota
+	// DialectPostgreSQL quotes identifiers using double quotes.
+	DialectPostgreSQL
+	// DialectMySQL quotes identifiers using backticks and escapes backslashes
+	// in string literals.
+	DialectMySQL
+)
+
+// SQLType represents the column type inferred by a SQLRenderer.
+type SQLType uint8
+
+const (
+	// SQLText is used for columns that do not match any other type.
+	SQLText SQLType = iota
+	// SQLInteger is used for columns holding whole numbers.
+	SQLInteger
+	// SQLReal is used for columns holding decimal numbers.
+	SQLReal
+	// SQLBoolean is used for columns holding true or false.
+	SQLBoolean
+	// SQLTimestamp is used for columns holding dates or date-times.
+	SQLTimestamp
+)
+
+// String returns the name of the type as used in CREATE TABLE statements.
+func (t SQLType) String() string {
+	switch t {
+	case SQLText:
+		return "TEXT"
+	case SQLInteger:
+		return "INTEGER"
+	case SQLReal:
+		return "REAL"
+	case SQLBoolean:
+		return "BOOLEAN"
+	case SQLTimestamp:
+		return "TIMESTAMP"
+	}
+	return fmt.Sprintf("SQLType(%d)", t)
+}
+
+// sqlTimestampLayouts are the layouts accepted for SQLTimestamp columns.
+var sqlTimestampLayouts = []string{
+	time.RFC3339Nano,
+	time.DateTime,
+	"2006-01-02T15:04:05",
+	time.DateOnly,
+}
+
+// sqlTimestampFormat is the layout of timestamp literals. Fractional seconds
+// are kept, without trailing zeros.
+const sqlTimestampFormat = "2006-01-02 15:04:05.999999999"
+
+// SQLOption represents an option for configuring a SQLRenderer.
+type SQLOption func(*SQLRenderer)
+
+// SQLRenderer renders a table as SQL CREATE TABLE and INSERT statements.
+type SQLRenderer struct {
+	table       *Table
+	tableName   string
+	dialect     SQLDialect
+	batchSize   int
+	createTable bool
+}
+
+// NewSQLRenderer creates a SQLRenderer for the given table. The statements
+// use tableName as the name of the SQL table.
+func NewSQLRenderer(table *Table, tableName string, opts ...SQLOption) *SQLRenderer {
+	r := &SQLRenderer{
+		table:       table,
+		tableName:   tableName,
+		dialect:     DialectSQLite,
+		batchSize:   100,
+		createTable: true,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// WithSQLDialect sets the SQL dialect. The default is DialectSQLite.
+func WithSQLDialect(dialect SQLDialect) SQLOption {
+	return func(r *SQLRenderer) {
+		r.dialect = dialect
+	}
+}
+
+// WithSQLBatchSize sets the maximum number of rows per INSERT statement. The
+// default is 100.
+func WithSQLBatchSize(size int) SQLOption {
+	return func(r *SQLRenderer) {
+		r.batchSize = size
+	}
+}
+
+// WithSQLCreateTable sets whether a CREATE TABLE statement is written before
+// the INSERT statements. It is written by default.
+func WithSQLCreateTable(createTable bool) SQLOption {
+	return func(r *SQLRenderer) {
+		r.createTable = createTable
+	}
+}
+
+// ColumnTypes returns the SQL type inferred for each column. A column gets the
+// most specific type that all of its non-empty values match.
+func (r *SQLRenderer) ColumnTypes() []SQLType {
+	r.table.mu.RLock()
+	defer r.table.mu.RUnlock()
+
+	return r.columnTypes()
+}
+
+// Render writes the SQL statements to w.
+func (r *SQLRenderer) Render(w io.Writer) error {
+	if r.tableName == "" {
+		return errors.New("table name must not be empty")
+	}
+	if r.batchSize < 1 {
+		return fmt.Errorf("invalid batch size: %d", r.batchSize)
+	}
+	if r.dialect > DialectMySQL {
+		return fmt.Errorf("invalid SQL dialect: %d", r.dialect)
+	}
+
+	r.table.mu.RLock()
+	defer r.table.mu.RUnlock()
+
+	if len(r.table.columns) == 0 {
+		return errors.New("table has no columns")
+	}
+
+	types := r.columnTypes()
+	bw := bufio.NewWriter(w)
+
+	names := make([]string, len(r.table.columns))
+	for i, col := range r.table.columns {
+		names[i] = r.quoteIdentifier(col)
+	}
+
+	if r.createTable {
+		fmt.Fprintf(bw, "CREATE TABLE %s (\n", r.quoteIdentifier(r.tableName))
+		for i, name := range names {
+			fmt.Fprintf(bw, "  %s %s", name, types[i])
+			if i < len(names)-1 {
+				bw.WriteString(",")
+			}
+			bw.WriteString("\n")
+		}
+		bw.WriteString(");\n")
+	}
+
+	for start := 0; start < len(r.table.rows); start += r.batchSize {
+		end := min(start+r.batchSize, len(r.table.rows))
+
+		fmt.Fprintf(bw, "INSERT INTO %s (%s) VALUES\n", r.quoteIdentifier(r.tableName), strings.Join(names, ", "))
+		for i, row := range r.table.rows[start:end] {
+			values := make([]string, len(row))
+			for j, cell := range row {
+				values[j] = r.literal(cell, types[j])
+			}
+			fmt.Fprintf(bw, "  (%s)", strings.Join(values, ", "))
+			if start+i < end-1 {
+				bw.WriteString(",\n")
+			}
+		}
+		bw.WriteString(";\n")
+	}
+
+	return bw.Flush()
+}
+
+// columnTypes infers the type of each column without locking.
+func (r *SQLRenderer) columnTypes() []SQLType {
+	types := make([]SQLType, len(r.table.columns))
+	for i := range r.table.columns {
+		types[i] = r.columnType(i)
+	}
+
+	return types
+}
+
+// columnType infers the type of the column at the given index.
+func (r *SQLRenderer) columnType(index int) SQLType {
+	candidates := []SQLType{SQLInteger, SQLReal, SQLBoolean, SQLTimestamp}
+	empty := true
+
+	for _, row := range r.table.rows {
+		cell := row[index]
+		if cell == "" {
+			continue
+		}
+		empty = false
+
+		remaining := candidates[:0]
+		for _, typ := range candidates {
+			if sqlTypeMatches(typ, cell) {
+				remaining = append(remaining, typ)
+			}
+		}
+		candidates = remaining
+		if len(candidates) == 0 {
+			return SQLText
+		}
+	}
+
+	if empty {
+		return SQLText
+	}
+
+	return candidates[0]
+}
+
+// sqlTypeMatches reports whether s can be stored as typ.
+func sqlTypeMatches(typ SQLType, s string) bool {
+	switch typ {
+	case SQLInteger:
+		return isInteger(s)
+	case SQLReal:
+		return isNumber(s)
+	case SQLBoolean:
+		_, ok := parseSQLBool(s)
+		return ok
+	case SQLTimestamp:
+		_, ok := parseSQLTimestamp(s)
+		return ok
+	}
+	return true
+}
+
+// literal returns s as a SQL literal of the given type. Empty cells in
+// non-text columns become NULL.
+func (r *SQLRenderer) literal(s string, typ SQLType) string {
+	if s == "" && typ != SQLText {
+		return "NULL"
+	}
+
+	switch typ {
+	case SQLInteger, SQLReal:
+		return s
+	case SQLBoolean:
+		if b, _ := parseSQLBool(s); b {
+			return "TRUE"
+		}
+		return "FALSE"
+	case SQLTimestamp:
+		ts, _ := parseSQLTimestamp(s)
+		return r.quoteString(ts.Format(sqlTimestampFormat))
+	}
+
+	return r.quoteString(s)
+}
+
+// quoteString returns s as a quoted SQL string literal.
+func (r *SQLRenderer) quoteString(s string) string {
+	if r.dialect == DialectMySQL {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// quoteIdentifier returns s as a quoted SQL identifier.
+func (r *SQLRenderer) quoteIdentifier(s string) string {
+	if r.dialect == DialectMySQL {
+		return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+	}
+
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// parseSQLBool parses "true" or "false", ignoring case.
+func parseSQLBool(s string) (value, ok bool) {
+	switch strings.ToLower(s) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+// parseSQLTimestamp parses s using one of the accepted timestamp layouts.
+// Timestamps with a time zone are converted to UTC.
+func parseSQLTimestamp(s string) (time.Time, bool) {
+	for _, layout := range sqlTimestampLayouts {
+		if ts, err := time.Parse(layout, s); err == nil {
+			return ts.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
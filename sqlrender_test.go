@@ -0,0 +1,174 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestSQLRenderer_Render(t *testing.T) {
+	tests := []struct {
+		name      string
+		columns   []string
+		rows      [][]string
+		tableName string
+		options   []tablr.SQLOption
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:    "Inferred types",
+			columns: []string{"id", "name", "score", "active", "created", "zip"},
+			rows: [][]string{
+				{"1", "John", "9.5", "true", "2024-05-17", "0123"},
+				{"2", "O'Brien", "10", "FALSE", "2024-05-17T13:04:05+02:00", "4567"},
+				{"", "", "", "", "", ""},
+			},
+			tableName: "people",
+			want: `CREATE TABLE "people" (
+  "id" INTEGER,
+  "name" TEXT,
+  "score" REAL,
+  "active" BOOLEAN,
+  "created" TIMESTAMP,
+  "zip" TEXT
+);
+INSERT INTO "people" ("id", "name", "score", "active", "created", "zip") VALUES
+  (1, 'John', 9.5, TRUE, '2024-05-17 00:00:00', '0123'),
+  (2, 'O''Brien', 10, FALSE, '2024-05-17 11:04:05', '4567'),
+  (NULL, '', NULL, NULL, NULL, '');
+`,
+		},
+		{
+			name:    "Fractional seconds",
+			columns: []string{"at"},
+			rows: [][]string{
+				{"2024-01-01T10:00:00.123456Z"},
+				{"2024-01-01 10:00:00.5"},
+				{"2024-01-01T12:00:00.000+02:00"},
+			},
+			tableName: "events",
+			options:   []tablr.SQLOption{tablr.WithSQLCreateTable(false)},
+			want: `INSERT INTO "events" ("at") VALUES
+  ('2024-01-01 10:00:00.123456'),
+  ('2024-01-01 10:00:00.5'),
+  ('2024-01-01 10:00:00');
+`,
+		},
+		{
+			name:    "Batches",
+			columns: []string{"n"},
+			rows: [][]string{
+				{"1"}, {"2"}, {"3"},
+			},
+			tableName: "numbers",
+			options: []tablr.SQLOption{
+				tablr.WithSQLBatchSize(2),
+				tablr.WithSQLCreateTable(false),
+			},
+			want: `INSERT INTO "numbers" ("n") VALUES
+  (1),
+  (2);
+INSERT INTO "numbers" ("n") VALUES
+  (3);
+`,
+		},
+		{
+			name:    "PostgreSQL identifier quoting",
+			columns: []string{`say "hi"`},
+			rows: [][]string{
+				{`back\slash`},
+			},
+			tableName: "quotes",
+			options:   []tablr.SQLOption{tablr.WithSQLDialect(tablr.DialectPostgreSQL)},
+			want: `CREATE TABLE "quotes" (
+  "say ""hi""" TEXT
+);
+INSERT INTO "quotes" ("say ""hi""") VALUES
+  ('back\slash');
+`,
+		},
+		{
+			name:    "MySQL identifier quoting and escaping",
+			columns: []string{"odd`name"},
+			rows: [][]string{
+				{`it's a back\slash`},
+			},
+			tableName: "quotes",
+			options:   []tablr.SQLOption{tablr.WithSQLDialect(tablr.DialectMySQL)},
+			want: "CREATE TABLE `quotes` (\n" +
+				"  `odd``name` TEXT\n" +
+				");\n" +
+				"INSERT INTO `quotes` (`odd``name`) VALUES\n" +
+				"  ('it''s a back\\\\slash');\n",
+		},
+		{
+			name:      "No rows",
+			columns:   []string{"id"},
+			tableName: "empty",
+			want: `CREATE TABLE "empty" (
+  "id" TEXT
+);
+`,
+		},
+		{
+			name:      "Empty table name",
+			columns:   []string{"id"},
+			tableName: "",
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid batch size",
+			columns:   []string{"id"},
+			tableName: "t",
+			options:   []tablr.SQLOption{tablr.WithSQLBatchSize(0)},
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid dialect",
+			columns:   []string{"id"},
+			tableName: "t",
+			options:   []tablr.SQLOption{tablr.WithSQLDialect(tablr.SQLDialect(9))},
+			wantErr:   true,
+		},
+		{
+			name:      "No columns",
+			tableName: "t",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns)
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := tablr.NewSQLRenderer(table, tt.tableName, tt.options...).Render(&buf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLRenderer_ColumnTypes(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"a", "b", "c", "d"})
+	table.AddRows([][]string{
+		{"1", "1", "yes", ""},
+		{"2", "2.5", "no", ""},
+	})
+
+	want := []tablr.SQLType{tablr.SQLInteger, tablr.SQLReal, tablr.SQLText, tablr.SQLText}
+	got := tablr.NewSQLRenderer(table, "t").ColumnTypes()
+	if !equalSlices(got, want) {
+		t.Errorf("ColumnTypes() got = %v, want %v", got, want)
+	}
+}
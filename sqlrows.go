@@ -0,0 +1,125 @@
+package tablr
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FromSQLRows creates a table from a database/sql result set.
+//
+// The column names are taken from rows.Columns(). NULL values are rendered
+// using the placeholder set by WithNullPlaceholder, byte slices are rendered as
+// text if they hold valid UTF-8 and as hexadecimal otherwise, and time values
+// are formatted using the layout set by WithTimeFormat. Columns reported as
+// numeric by rows.ColumnTypes() are right-aligned.
+//
+// The caller is responsible for closing rows.
+func FromSQLRows(rows *sql.Rows, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	alignments := make([]Alignment, len(columns))
+	if types, err := rows.ColumnTypes(); err == nil {
+		for i, ct := range types {
+			if isNumericColumnType(ct) {
+				alignments[i] = AlignRight
+			}
+		}
+	}
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	var data [][]string
+	for !c.rowLimitReached(len(data)) && rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(columns))
+		for i, v := range values {
+			row[i] = c.sqlString(v)
+		}
+		data = append(data, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return c.newTable(columns, data, alignments), nil
+}
+
+// sqlString returns the cell value for a value scanned from a result set.
+func (c *importConfig) sqlString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return c.nullPlaceholder
+	case string:
+		return v
+	case []byte:
+		if utf8.Valid(v) {
+			return string(v)
+		}
+		return "0x" + hex.EncodeToString(v)
+	case time.Time:
+		return v.Format(c.timeFormat)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// numericDatabaseTypes holds the database type names that are considered
+// numeric. Names are matched without any precision, e.g. "DECIMAL(10,2)".
+var numericDatabaseTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "TINYINT": true, "SMALLINT": true,
+	"MEDIUMINT": true, "BIGINT": true, "INT2": true, "INT4": true,
+	"INT8": true, "SERIAL": true, "BIGSERIAL": true, "DECIMAL": true,
+	"NUMERIC": true, "REAL": true, "FLOAT": true, "FLOAT4": true,
+	"FLOAT8": true, "DOUBLE": true, "DOUBLE PRECISION": true, "MONEY": true,
+	"UNSIGNED BIGINT": true, "UNSIGNED INT": true,
+}
+
+// isNumericColumnType reports whether the column holds numbers, based on its
+// scan type or, if that is inconclusive, its database type name.
+func isNumericColumnType(ct *sql.ColumnType) bool {
+	if t := ct.ScanType(); t != nil {
+		switch t {
+		case reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullInt32{}),
+			reflect.TypeOf(sql.NullInt16{}), reflect.TypeOf(sql.NullFloat64{}),
+			reflect.TypeOf(sql.NullByte{}):
+			return true
+		}
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+	}
+
+	name := strings.ToUpper(ct.DatabaseTypeName())
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = name[:i]
+	}
+
+	return numericDatabaseTypes[strings.TrimSpace(name)]
+}
@@ -0,0 +1,275 @@
+package tablr_test
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+// fakeResult is a result set served by the fake driver.
+type fakeResult struct {
+	columns   []string
+	types     []string
+	scanTypes []reflect.Type
+	rows      [][]driver.Value
+	err       error
+}
+
+var (
+	fakeResultsMu sync.Mutex
+	fakeResults   = make(map[string]*fakeResult)
+)
+
+func init() {
+	sql.Register("tablrfake", fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeResultsMu.Lock()
+	defer fakeResultsMu.Unlock()
+
+	result, ok := fakeResults[name]
+	if !ok {
+		return nil, errors.New("unknown fake result")
+	}
+
+	return &fakeConn{result: result}, nil
+}
+
+type fakeConn struct {
+	result *fakeResult
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{result: c.result}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	result *fakeResult
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{result: s.result}, nil
+}
+
+type fakeRows struct {
+	result *fakeResult
+	pos    int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.result.rows) {
+		if r.result.err != nil {
+			return r.result.err
+		}
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func (r *fakeRows) ColumnTypeDatabaseTypeName(index int) string {
+	if index < len(r.result.types) {
+		return r.result.types[index]
+	}
+	return ""
+}
+
+func (r *fakeRows) ColumnTypeScanType(index int) reflect.Type {
+	if index < len(r.result.scanTypes) && r.result.scanTypes[index] != nil {
+		return r.result.scanTypes[index]
+	}
+	return reflect.TypeOf(new(any)).Elem()
+}
+
+// queryFake returns the rows of the given fake result.
+func queryFake(t *testing.T, result *fakeResult) *sql.Rows {
+	t.Helper()
+
+	fakeResultsMu.Lock()
+	fakeResults[t.Name()] = result
+	fakeResultsMu.Unlock()
+
+	db, err := sql.Open("tablrfake", t.Name())
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	t.Cleanup(func() { rows.Close() })
+
+	return rows
+}
+
+func TestFromSQLRows(t *testing.T) {
+	created := time.Date(2024, 5, 17, 13, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		result         *fakeResult
+		options        []tablr.ImportOption
+		wantColumns    []string
+		wantRows       [][]string
+		wantAlignments []tablr.Alignment
+		wantErr        bool
+	}{
+		{
+			name: "Values are formatted",
+			result: &fakeResult{
+				columns: []string{"id", "name", "score", "active", "created", "data"},
+				types:   []string{"INTEGER", "TEXT", "DECIMAL(10,2)", "BOOLEAN", "TIMESTAMP", "BLOB"},
+				rows: [][]driver.Value{
+					{int64(1), "John", 9.5, true, created, []byte("text")},
+					{int64(2), nil, float64(10), false, nil, []byte{0xff, 0x00}},
+				},
+			},
+			wantColumns: []string{"id", "name", "score", "active", "created", "data"},
+			wantRows: [][]string{
+				{"1", "John", "9.5", "true", "2024-05-17T13:04:05Z", "text"},
+				{"2", "NULL", "10", "false", "NULL", "0xff00"},
+			},
+			wantAlignments: []tablr.Alignment{
+				tablr.AlignRight, tablr.AlignDefault, tablr.AlignRight,
+				tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault,
+			},
+		},
+		{
+			name: "Numeric scan types",
+			result: &fakeResult{
+				columns:   []string{"count", "ratio", "label"},
+				scanTypes: []reflect.Type{reflect.TypeOf(int32(0)), reflect.TypeOf(sql.NullFloat64{}), reflect.TypeOf("")},
+				rows: [][]driver.Value{
+					{int64(3), 0.25, "x"},
+				},
+			},
+			wantColumns: []string{"count", "ratio", "label"},
+			wantRows: [][]string{
+				{"3", "0.25", "x"},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignRight, tablr.AlignRight, tablr.AlignDefault},
+		},
+		{
+			name: "Null placeholder and time format",
+			result: &fakeResult{
+				columns: []string{"name", "created"},
+				rows: [][]driver.Value{
+					{nil, created},
+				},
+			},
+			options: []tablr.ImportOption{
+				tablr.WithNullPlaceholder("-"),
+				tablr.WithTimeFormat(time.DateOnly),
+			},
+			wantColumns: []string{"name", "created"},
+			wantRows: [][]string{
+				{"-", "2024-05-17"},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name: "Allowed columns and row limit",
+			result: &fakeResult{
+				columns: []string{"id", "name"},
+				types:   []string{"BIGINT", "VARCHAR"},
+				rows: [][]driver.Value{
+					{int64(1), "John"},
+					{int64(2), "Jane"},
+				},
+			},
+			options: []tablr.ImportOption{
+				tablr.WithAllowedColumns("id"),
+				tablr.WithRowLimit(1),
+			},
+			wantColumns: []string{"id"},
+			wantRows: [][]string{
+				{"1"},
+			},
+			wantAlignments: []tablr.Alignment{tablr.AlignRight},
+		},
+		{
+			name: "Iteration error",
+			result: &fakeResult{
+				columns: []string{"id"},
+				rows: [][]driver.Value{
+					{int64(1)},
+				},
+				err: errors.New("connection lost"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := queryFake(t, tt.result)
+
+			table, err := tablr.FromSQLRows(rows, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromSQLRows() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromSQLRows() columns = %v, want %v", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromSQLRows() rows = %v, want %v", got, tt.wantRows)
+			}
+			if got := table.GetAlignments(); !equalSlices(got, tt.wantAlignments) {
+				t.Errorf("FromSQLRows() alignments = %v, want %v", got, tt.wantAlignments)
+			}
+		})
+	}
+}
+
+func TestFromSQLRows_Render(t *testing.T) {
+	rows := queryFake(t, &fakeResult{
+		columns: []string{"name", "qty"},
+		types:   []string{"TEXT", "INTEGER"},
+		rows: [][]driver.Value{
+			{"apples", int64(12)},
+			{"pears", int64(3)},
+		},
+	})
+
+	var buf bytes.Buffer
+	table, err := tablr.FromSQLRows(rows, tablr.WithWriter(&buf))
+	if err != nil {
+		t.Fatalf("FromSQLRows() error = %v", err)
+	}
+	table.Render()
+
+	want := `| name   | qty |
+|--------|----:|
+| apples |  12 |
+| pears  |   3 |
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Render() got = \n%v, want \n%v", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+package tablr
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// SVGOption represents an option for configuring SVG output.
+type SVGOption func(*svgConfig)
+
+type svgConfig struct {
+	fontFamily       string
+	fontSize         float64
+	charWidth        float64
+	textColor        string
+	background       string
+	headerBackground string
+	stripeColor      string
+	gridColor        string
+}
+
+// WithSVGFont sets the font family and size, in pixels, of the text. The font
+// should be monospaced, as the text is laid out by counting characters. The
+// default is 13 pixel monospace.
+func WithSVGFont(family string, size float64) SVGOption {
+	return func(c *svgConfig) {
+		c.fontFamily = family
+		c.fontSize = size
+	}
+}
+
+// WithSVGCharWidth sets the width, in pixels, of a single character of the
+// font. Wide characters, such as CJK ideographs, take up twice this width.
+// The default is 0.6 times the font size, which fits most monospace fonts.
+func WithSVGCharWidth(width float64) SVGOption {
+	return func(c *svgConfig) {
+		c.charWidth = width
+	}
+}
+
+// WithSVGColors sets the colors of the text and the background. The default
+// is black text on white.
+func WithSVGColors(text, background string) SVGOption {
+	return func(c *svgConfig) {
+		c.textColor = text
+		c.background = background
+	}
+}
+
+// WithSVGHeaderBackground sets the background color of the header row. An
+// empty color disables the header background. The default is "#eeeeee".
+func WithSVGHeaderBackground(color string) SVGOption {
+	return func(c *svgConfig) {
+		c.headerBackground = color
+	}
+}
+
+// WithSVGStripeColor sets the background color of every other data row,
+// starting with the second. An empty color disables the stripes. The default
+// is "#f7f7f7".
+func WithSVGStripeColor(color string) SVGOption {
+	return func(c *svgConfig) {
+		c.stripeColor = color
+	}
+}
+
+// WithSVGGridColor sets the color of the grid lines. An empty color disables
+// the grid lines. The default is "#cccccc".
+func WithSVGGridColor(color string) SVGOption {
+	return func(c *svgConfig) {
+		c.gridColor = color
+	}
+}
+
+// RenderSVG renders the table as a self-contained SVG image to the given
+// writer. Cells containing line breaks span multiple lines.
+func (t *Table) RenderSVG(w io.Writer, opts ...SVGOption) error {
+	c := &svgConfig{
+		fontFamily:       "monospace",
+		fontSize:         13,
+		textColor:        "#000000",
+		background:       "#ffffff",
+		headerBackground: "#eeeeee",
+		stripeColor:      "#f7f7f7",
+		gridColor:        "#cccccc",
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.fontSize <= 0 {
+		return fmt.Errorf("invalid font size: %v", c.fontSize)
+	}
+	if c.charWidth == 0 {
+		c.charWidth = 0.6 * c.fontSize
+	}
+	if c.charWidth < 0 {
+		return fmt.Errorf("invalid character width: %v", c.charWidth)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	paddingX := c.charWidth
+	lineHeight := 1.5 * c.fontSize
+
+	xs := make([]float64, len(t.columns)+1)
+	for i, col := range t.columns {
+		chars := displayWidth(col)
+		for _, row := range t.rows {
+			chars = max(chars, displayWidth(row[i]))
+		}
+		xs[i+1] = xs[i] + float64(chars)*c.charWidth + 2*paddingX
+	}
+
+	rows := append([][]string{t.columns}, t.rows...)
+	ys := make([]float64, len(rows)+1)
+	for i, row := range rows {
+		height := 1
+		for _, cell := range row {
+			height = max(height, len(cellLines(cell)))
+		}
+		ys[i+1] = ys[i] + float64(height)*lineHeight
+	}
+
+	width, height := xs[len(xs)-1], ys[len(ys)-1]
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %[1]s %[2]s" font-family="%s" font-size="%s">`+"\n",
+		svgNumber(width), svgNumber(height), escapeXML(c.fontFamily), svgNumber(c.fontSize))
+
+	if c.background != "" {
+		fmt.Fprintf(bw, `<rect width="%s" height="%s" fill="%s"/>`+"\n", svgNumber(width), svgNumber(height), escapeXML(c.background))
+	}
+	if c.headerBackground != "" {
+		writeSVGRect(bw, 0, ys[0], width, ys[1]-ys[0], c.headerBackground)
+	}
+	if c.stripeColor != "" {
+		for i := 2; i < len(rows); i += 2 {
+			writeSVGRect(bw, 0, ys[i], width, ys[i+1]-ys[i], c.stripeColor)
+		}
+	}
+
+	if c.gridColor != "" {
+		fmt.Fprintf(bw, `<g stroke="%s" stroke-width="1">`+"\n", escapeXML(c.gridColor))
+		for _, y := range ys {
+			fmt.Fprintf(bw, `<line x1="0" y1="%s" x2="%s" y2="%[1]s"/>`+"\n", svgNumber(y), svgNumber(width))
+		}
+		for _, x := range xs {
+			fmt.Fprintf(bw, `<line x1="%s" y1="0" x2="%[1]s" y2="%s"/>`+"\n", svgNumber(x), svgNumber(height))
+		}
+		bw.WriteString("</g>\n")
+	}
+
+	fmt.Fprintf(bw, `<g fill="%s" dominant-baseline="central">`+"\n", escapeXML(c.textColor))
+	for i, row := range rows {
+		alignments, weight := t.columnAlignments, ""
+		if i == 0 {
+			alignments, weight = t.headerAlignments, ` font-weight="bold"`
+		}
+		for col, cell := range row {
+			x, anchor := xs[col]+paddingX, "start"
+			switch alignments[col] {
+			case AlignCenter:
+				x, anchor = (xs[col]+xs[col+1])/2, "middle"
+			case AlignRight:
+				x, anchor = xs[col+1]-paddingX, "end"
+			}
+			for n, line := range cellLines(cell) {
+				if line == "" {
+					continue
+				}
+				y := ys[i] + (float64(n)+0.5)*lineHeight
+				fmt.Fprintf(bw, `<text x="%s" y="%s" text-anchor="%s"%s xml:space="preserve">%s</text>`+"\n",
+					svgNumber(x), svgNumber(y), anchor, weight, escapeXML(line))
+			}
+		}
+	}
+	bw.WriteString("</g>\n</svg>\n")
+
+	return bw.Flush()
+}
+
+// writeSVGRect writes a filled rectangle.
+func writeSVGRect(w *bufio.Writer, x, y, width, height float64, fill string) {
+	fmt.Fprintf(w, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n",
+		svgNumber(x), svgNumber(y), svgNumber(width), svgNumber(height), escapeXML(fill))
+}
+
+// svgNumber formats a coordinate rounded to two decimals, without trailing
+// zeros.
+func svgNumber(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+// escapeXML escapes s for use in XML text and attribute values.
+func escapeXML(s string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
@@ -0,0 +1,126 @@
+package tablr_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderSVG(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age"},
+		tablr.WithAlignments([]tablr.Alignment{tablr.AlignLeft, tablr.AlignRight}),
+	)
+	table.AddRow([]string{"Jo & <b>", "30"})
+
+	var buf bytes.Buffer
+	if err := table.RenderSVG(&buf, tablr.WithSVGFont("Fira Code", 10), tablr.WithSVGCharWidth(6)); err != nil {
+		t.Fatalf("RenderSVG() error = %v", err)
+	}
+
+	want := `<svg xmlns="http://www.w3.org/2000/svg" width="90" height="30" viewBox="0 0 90 30" font-family="Fira Code" font-size="10">
+<rect width="90" height="30" fill="#ffffff"/>
+<rect x="0" y="0" width="90" height="15" fill="#eeeeee"/>
+<g stroke="#cccccc" stroke-width="1">
+<line x1="0" y1="0" x2="90" y2="0"/>
+<line x1="0" y1="15" x2="90" y2="15"/>
+<line x1="0" y1="30" x2="90" y2="30"/>
+<line x1="0" y1="0" x2="0" y2="30"/>
+<line x1="60" y1="0" x2="60" y2="30"/>
+<line x1="90" y1="0" x2="90" y2="30"/>
+</g>
+<g fill="#000000" dominant-baseline="central">
+<text x="6" y="7.5" text-anchor="start" font-weight="bold" xml:space="preserve">Name</text>
+<text x="84" y="7.5" text-anchor="end" font-weight="bold" xml:space="preserve">Age</text>
+<text x="6" y="22.5" text-anchor="start" xml:space="preserve">Jo &amp; &lt;b&gt;</text>
+<text x="84" y="22.5" text-anchor="end" xml:space="preserve">30</text>
+</g>
+</svg>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderSVG() got = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestTable_RenderSVG_Options(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    []tablr.SVGOption
+		contains   []string
+		notContain []string
+		wantErr    bool
+	}{
+		{
+			name: "Defaults",
+			contains: []string{
+				`font-family="monospace" font-size="13"`,
+				`<rect x="0" y="58.5" width="`,
+				`fill="#f7f7f7"/>`,
+				`text-anchor="middle"`,
+				`<text x="7.8" y="29.25" text-anchor="start" xml:space="preserve">line 1</text>`,
+				`<text x="7.8" y="48.75" text-anchor="start" xml:space="preserve">line 2</text>`,
+			},
+		},
+		{
+			name: "Stripes, grid and header background disabled",
+			options: []tablr.SVGOption{
+				tablr.WithSVGStripeColor(""),
+				tablr.WithSVGGridColor(""),
+				tablr.WithSVGHeaderBackground(""),
+				tablr.WithSVGColors("#ffffff", "#000000"),
+			},
+			contains:   []string{`fill="#000000"/>`, `<g fill="#ffffff"`},
+			notContain: []string{"#f7f7f7", "<line", "#eeeeee"},
+		},
+		{
+			name:    "Invalid font size",
+			options: []tablr.SVGOption{tablr.WithSVGFont("monospace", 0)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age", "City"}, tablr.WithAlignments(defaultAlignments))
+			table.AddRows([][]string{
+				{"line 1\nline 2", "30", "New York"},
+				{"Jane", "25", "Los Angeles"},
+			})
+
+			var buf bytes.Buffer
+			err := table.RenderSVG(&buf, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderSVG() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := buf.String()
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("RenderSVG() output does not contain %q:\n%v", s, got)
+				}
+			}
+			for _, s := range tt.notContain {
+				if strings.Contains(got, s) {
+					t.Errorf("RenderSVG() output contains %q:\n%v", s, got)
+				}
+			}
+
+			dec := xml.NewDecoder(strings.NewReader(got))
+			for {
+				if _, err := dec.Token(); err != nil {
+					if !errors.Is(err, io.EOF) {
+						t.Errorf("RenderSVG() output is not well-formed: %v", err)
+					}
+					break
+				}
+			}
+		})
+	}
+}
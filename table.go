@@ -15,6 +15,12 @@ type Table struct {
 	headerAlignments []Alignment
 	columnAlignments []Alignment
 	columnMinWidths  []int
+	headerRows       [][]string
+	colSpans         map[cellRef]int
+	caption          string
+	label            string
+	theme            Theme
+	colorMode        ColorMode
 }
 
 // New creates a new Markdown table with the given columns and options.
@@ -31,8 +37,7 @@ func New(writer io.Writer, columns []string, opts ...TableOption) *Table {
 
 	// Initialize columns
 	for i, col := range columns {
-		t.columns[i] = escapePipes(col)
-		t.columnMinWidths[i] = len(col)
+		t.columnMinWidths[i] = cellWidth(col)
 		t.headerAlignments[i] = AlignDefault
 		t.columnAlignments[i] = AlignDefault
 	}
@@ -44,6 +49,24 @@ func New(writer io.Writer, columns []string, opts ...TableOption) *Table {
 	return t
 }
 
+// SetCaption sets the caption of the table and the label used to reference
+// it. Renderers for formats that support captions use these values.
+func (t *Table) SetCaption(caption, label string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.caption = caption
+	t.label = label
+}
+
+// GetCaption returns the caption and label of the table.
+func (t *Table) GetCaption() (caption, label string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.caption, t.label
+}
+
 // AddRow appends a row to the table.
 // If the number of columns in the row is less than the number of columns in the
 // table, the row will be padded with empty strings.
@@ -61,10 +84,6 @@ func (t *Table) AddRow(row []string) {
 func (t *Table) addRowInternal(row []string) {
 	row = t.adjustRowLength(row)
 
-	for i, val := range row {
-		row[i] = escapePipes(val)
-	}
-
 	t.rows = append(t.rows, row)
 }
 
@@ -96,6 +115,7 @@ func (t *Table) GetRow(index int) ([]string, error) {
 }
 
 // GetRows returns the rows in the table.
+// The values are returned as they were added, without any Markdown escaping.
 func (t *Table) GetRows() [][]string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -119,16 +139,15 @@ func (t *Table) SetRows(rows [][]string) {
 	for rowIndex, row := range rows {
 		adjustedRow := t.adjustRowLength(row)
 		for colIndex, val := range adjustedRow {
-			escapedVal := escapePipes(val)
-			adjustedRow[colIndex] = escapedVal
 			// Update columnMinWidths with the minimum width. This makes sure a
 			// previously set larger columnMinWidths[colIndex] it not used
-			t.columnMinWidths[colIndex] = max(t.columnMinWidths[colIndex], len(escapedVal), len(t.columns[colIndex]))
+			t.columnMinWidths[colIndex] = max(t.columnMinWidths[colIndex], cellWidth(val), cellWidth(t.columns[colIndex]))
 		}
 		newRows[rowIndex] = adjustedRow
 	}
 
 	t.rows = newRows
+	t.clearSpans(false)
 	t.adjustColumnWidths()
 }
 
@@ -145,10 +164,6 @@ func (t *Table) SetRow(index int, row []string) error {
 		return fmt.Errorf("incorrect number of values in row, should be %d", len(t.columns))
 	}
 
-	for i, val := range row {
-		row[i] = escapePipes(val)
-	}
-
 	row = t.adjustRowLength(row)
 
 	t.rows[index] = row
@@ -168,6 +183,7 @@ func (t *Table) DeleteRow(index int) error {
 
 	copy(t.rows[index:], t.rows[index+1:])
 	t.rows = t.rows[:len(t.rows)-1]
+	t.deleteRowSpans(index)
 
 	t.adjustColumnWidths()
 
@@ -181,6 +197,8 @@ func (t *Table) Reset() {
 
 	t.columns = make([]string, 0)
 	t.rows = make([][]string, 0)
+	t.headerRows = nil
+	t.clearSpans(true)
 
 	t.adjustColumnWidths()
 }
@@ -204,8 +222,6 @@ func (t *Table) AddColumn(header string, opts ...ColumnOption) {
 
 // addColumnInternal adds a column to the table without locking.
 func (t *Table) addColumnInternal(header string, c *column) {
-	header = escapePipes(header)
-
 	if !c.alignment.IsValid() {
 		c.alignment = AlignDefault
 	}
@@ -214,13 +230,16 @@ func (t *Table) addColumnInternal(header string, c *column) {
 	}
 
 	t.columns = append(t.columns, header)
-	t.columnMinWidths = append(t.columnMinWidths, len(header))
+	t.columnMinWidths = append(t.columnMinWidths, cellWidth(header))
 	t.headerAlignments = append(t.headerAlignments, c.headerAlignment)
 	t.columnAlignments = append(t.columnAlignments, c.alignment)
 
 	for i, row := range t.rows {
 		t.rows[i] = append(row, "")
 	}
+	for i, row := range t.headerRows {
+		t.headerRows[i] = append(row, "")
+	}
 }
 
 // AddColumns appends multiple columns to the table.
@@ -263,6 +282,11 @@ func (t *Table) DeleteColumn(index int) error {
 		copy(row[index:], row[index+1:])
 		t.rows[i] = row[:len(row)-1]
 	}
+	for i, row := range t.headerRows {
+		copy(row[index:], row[index+1:])
+		t.headerRows[i] = row[:len(row)-1]
+	}
+	t.deleteColumnSpans(index)
 	t.adjustRowLenghts()
 
 	return nil
@@ -421,6 +445,16 @@ func (t *Table) ReorderColumns(newOrder []int) error {
 		}
 		t.rows[i] = newRow
 	}
+	for i, row := range t.headerRows {
+		newRow := make([]string, len(row))
+		for j, newIndex := range newOrder {
+			newRow[j] = row[newIndex]
+		}
+		t.headerRows[i] = newRow
+	}
+
+	// Column spans cannot be preserved when columns change places
+	t.clearSpans(true)
 
 	return nil
 }
@@ -550,8 +584,8 @@ func (t *Table) adjustColumnWidths() {
 
 	// Default to column header lengths
 	for i, col := range t.columns {
-		if len(col) > t.columnMinWidths[i] {
-			t.columnMinWidths[i] = len(col)
+		if w := cellWidth(col); w > t.columnMinWidths[i] {
+			t.columnMinWidths[i] = w
 		}
 	}
 
@@ -559,7 +593,7 @@ func (t *Table) adjustColumnWidths() {
 	for _, row := range t.rows {
 		for col, cell := range row {
 			width := t.columnMinWidth(col)
-			cellLen := len(cell)
+			cellLen := cellWidth(cell)
 			if cellLen > width {
 				t.columnMinWidths[col] = cellLen
 			}
@@ -617,9 +651,13 @@ func (t *Table) adjustRowLength(row []string) []string {
 	return row
 }
 
-// adjustRowLenghts adjusts the length of each row to match the number of columns.
+// adjustRowLenghts adjusts the length of each row, including the extra header
+// rows, to match the number of columns.
 func (t *Table) adjustRowLenghts() {
 	for i, row := range t.rows {
 		t.rows[i] = t.adjustRowLength(row)
 	}
+	for i, row := range t.headerRows {
+		t.headerRows[i] = t.adjustRowLength(row)
+	}
 }
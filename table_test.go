@@ -1363,6 +1363,154 @@ func TestTable_ReorderColumns(t *testing.T) {
 	})
 }
 
+func TestTable_Caption(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, defaultColumns, tablr.WithCaption("People", "tab:people"))
+	if caption, label := table.GetCaption(); caption != "People" || label != "tab:people" {
+		t.Errorf("GetCaption() got = %q, %q, want %q, %q", caption, label, "People", "tab:people")
+	}
+
+	table.SetCaption("Cities", "")
+	if caption, label := table.GetCaption(); caption != "Cities" || label != "" {
+		t.Errorf("GetCaption() got = %q, %q, want %q, %q", caption, label, "Cities", "")
+	}
+}
+
+func TestTable_ColSpans(t *testing.T) {
+	newSpanTable := func(t *testing.T) *tablr.Table {
+		t.Helper()
+		table := tablr.New(&bytes.Buffer{}, []string{"A", "B", "C", "D"})
+		table.AddRows([][]string{
+			{"a1", "b1", "c1", "d1"},
+			{"a2", "b2", "c2", "d2"},
+			{"a3", "b3", "c3", "d3"},
+		})
+		table.AddHeaderRow([]string{"group", "", "other"})
+		if err := table.SetColSpan(1, 1, 3); err != nil {
+			t.Fatalf("SetColSpan() error = %v", err)
+		}
+		if err := table.SetColSpan(2, 0, 2); err != nil {
+			t.Fatalf("SetColSpan() error = %v", err)
+		}
+		if err := table.SetHeaderColSpan(0, 0, 2); err != nil {
+			t.Fatalf("SetHeaderColSpan() error = %v", err)
+		}
+		return table
+	}
+
+	t.Run("SetColSpan validation", func(t *testing.T) {
+		table := newSpanTable(t)
+		tests := []struct {
+			name           string
+			row, col, span int
+		}{
+			{"Row out of range", 3, 0, 2},
+			{"Column out of range", 0, 4, 2},
+			{"Span too wide", 0, 3, 2},
+			{"Span too small", 0, 0, 0},
+		}
+		for _, tt := range tests {
+			if err := table.SetColSpan(tt.row, tt.col, tt.span); err == nil {
+				t.Errorf("%s: SetColSpan(%d, %d, %d) expected error", tt.name, tt.row, tt.col, tt.span)
+			}
+		}
+		if err := table.SetHeaderColSpan(1, 0, 2); err == nil {
+			t.Error("SetHeaderColSpan() with invalid header row expected error")
+		}
+	})
+
+	t.Run("GetColSpan", func(t *testing.T) {
+		table := newSpanTable(t)
+		if got := table.GetColSpan(1, 1); got != 3 {
+			t.Errorf("GetColSpan(1, 1) got = %d, want 3", got)
+		}
+		if got := table.GetColSpan(0, 0); got != 1 {
+			t.Errorf("GetColSpan(0, 0) got = %d, want 1", got)
+		}
+		if err := table.SetColSpan(1, 1, 1); err != nil {
+			t.Fatalf("SetColSpan() error = %v", err)
+		}
+		if got := table.GetColSpan(1, 1); got != 1 {
+			t.Errorf("GetColSpan(1, 1) after reset got = %d, want 1", got)
+		}
+	})
+
+	t.Run("DeleteRow", func(t *testing.T) {
+		table := newSpanTable(t)
+		if err := table.DeleteRow(1); err != nil {
+			t.Fatalf("DeleteRow() error = %v", err)
+		}
+		if got := table.GetColSpan(1, 1); got != 1 {
+			t.Errorf("GetColSpan(1, 1) got = %d, want 1", got)
+		}
+		if got := table.GetColSpan(1, 0); got != 2 {
+			t.Errorf("GetColSpan(1, 0) got = %d, want 2", got)
+		}
+	})
+
+	t.Run("DeleteColumn", func(t *testing.T) {
+		table := newSpanTable(t)
+		if err := table.DeleteColumn(2); err != nil {
+			t.Fatalf("DeleteColumn() error = %v", err)
+		}
+		if got := table.GetColSpan(1, 1); got != 2 {
+			t.Errorf("GetColSpan(1, 1) got = %d, want 2", got)
+		}
+		if got := table.GetColSpan(2, 0); got != 2 {
+			t.Errorf("GetColSpan(2, 0) got = %d, want 2", got)
+		}
+		want := [][]string{{"group", "", ""}}
+		if got := table.GetHeaderRows(); !equalRows(got, want) {
+			t.Errorf("GetHeaderRows() got = %v, want %v", got, want)
+		}
+
+		if err := table.DeleteColumn(0); err != nil {
+			t.Fatalf("DeleteColumn() error = %v", err)
+		}
+		if got := table.GetColSpan(1, 0); got != 2 {
+			t.Errorf("GetColSpan(1, 0) got = %d, want 2", got)
+		}
+		if got := table.GetColSpan(2, 0); got != 1 {
+			t.Errorf("GetColSpan(2, 0) got = %d, want 1", got)
+		}
+	})
+
+	t.Run("ReorderColumns", func(t *testing.T) {
+		table := newSpanTable(t)
+		if err := table.ReorderColumns([]int{3, 2, 1, 0}); err != nil {
+			t.Fatalf("ReorderColumns() error = %v", err)
+		}
+		if got := table.GetColSpan(1, 1); got != 1 {
+			t.Errorf("GetColSpan(1, 1) got = %d, want 1", got)
+		}
+		want := [][]string{{"", "other", "", "group"}}
+		if got := table.GetHeaderRows(); !equalRows(got, want) {
+			t.Errorf("GetHeaderRows() got = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("SetRows and AddColumn", func(t *testing.T) {
+		table := newSpanTable(t)
+		table.SetRows([][]string{{"x"}, {"y"}, {"z"}})
+		if got := table.GetColSpan(1, 1); got != 1 {
+			t.Errorf("GetColSpan(1, 1) got = %d, want 1", got)
+		}
+
+		table.AddColumn("E")
+		want := [][]string{{"group", "", "other", "", ""}}
+		if got := table.GetHeaderRows(); !equalRows(got, want) {
+			t.Errorf("GetHeaderRows() got = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		table := newSpanTable(t)
+		table.Reset()
+		if got := table.GetHeaderRows(); len(got) != 0 {
+			t.Errorf("GetHeaderRows() got = %v, want none", got)
+		}
+	})
+}
+
 func TestTable_Concurrency(t *testing.T) {
 	t.Parallel()
 
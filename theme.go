@@ -0,0 +1,161 @@
+package tablr
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// Style represents ANSI SGR parameters, such as "1" for bold or "31" for a
+// red foreground, used to color the output of the terminal renderers.
+type Style string
+
+// Styles supported by most terminals. Styles can be combined using
+// CombineStyles.
+const (
+	StyleBold      Style = "1"
+	StyleDim       Style = "2"
+	StyleItalic    Style = "3"
+	StyleUnderline Style = "4"
+	StyleReverse   Style = "7"
+
+	StyleBlack   Style = "30"
+	StyleRed     Style = "31"
+	StyleGreen   Style = "32"
+	StyleYellow  Style = "33"
+	StyleBlue    Style = "34"
+	StyleMagenta Style = "35"
+	StyleCyan    Style = "36"
+	StyleWhite   Style = "37"
+	StyleGray    Style = "90"
+
+	StyleBgBlack   Style = "40"
+	StyleBgRed     Style = "41"
+	StyleBgGreen   Style = "42"
+	StyleBgYellow  Style = "43"
+	StyleBgBlue    Style = "44"
+	StyleBgMagenta Style = "45"
+	StyleBgCyan    Style = "46"
+	StyleBgWhite   Style = "47"
+	StyleBgGray    Style = "100"
+)
+
+// CombineStyles returns a style applying all the given styles. Where styles
+// conflict, the last one wins.
+func CombineStyles(styles ...Style) Style {
+	codes := make([]string, 0, len(styles))
+	for _, s := range styles {
+		if s != "" {
+			codes = append(codes, string(s))
+		}
+	}
+	return Style(strings.Join(codes, ";"))
+}
+
+// ColorMode determines whether the terminal renderers emit colors.
+type ColorMode uint8
+
+const (
+	// ColorAuto emits colors only when the writer is a terminal and the
+	// NO_COLOR environment variable is not set.
+	ColorAuto ColorMode = iota
+	// ColorAlways always emits colors.
+	ColorAlways
+	// ColorNever never emits colors.
+	ColorNever
+)
+
+// Theme holds the styles used by the terminal renderers, RenderBox and
+// RenderPlain. Empty styles are not written.
+type Theme struct {
+	// Header is the style of the column headers.
+	Header Style
+	// Border is the style of the borders drawn by RenderBox.
+	Border Style
+	// AlternateRow is the style of every other data row, starting with the
+	// second, e.g. a background color for zebra striping.
+	AlternateRow Style
+	// Columns holds the styles of the data cells by column header.
+	Columns map[string]Style
+	// Cell, if set, returns the style of a data cell given its row index,
+	// column index and value, e.g. to color negative numbers red.
+	Cell func(row, col int, value string) Style
+}
+
+// WithTheme sets the theme used by the terminal renderers.
+func WithTheme(theme Theme) TableOption {
+	return func(t *Table) {
+		t.theme = theme
+	}
+}
+
+// WithColor sets whether the terminal renderers emit colors. The default is
+// ColorAuto.
+func WithColor(mode ColorMode) TableOption {
+	return func(t *Table) {
+		t.colorMode = mode
+	}
+}
+
+// painter applies the styles of a theme to text, if colors are enabled.
+type painter struct {
+	theme   Theme
+	enabled bool
+}
+
+// painter returns the painter used when rendering to w.
+func (t *Table) painter(w io.Writer) painter {
+	return painter{
+		theme:   t.theme,
+		enabled: colorEnabled(w, t.colorMode),
+	}
+}
+
+// paint wraps s in the escape sequences of the given style.
+func (p painter) paint(s string, style Style) string {
+	if !p.enabled || style == "" || s == "" {
+		return s
+	}
+	return "\x1b[" + string(style) + "m" + s + "\x1b[0m"
+}
+
+// cellStyle returns the style of a data cell, combining the alternate row,
+// column and cell styles in that order.
+func (p painter) cellStyle(columns []string, row, col int, value string) Style {
+	if !p.enabled {
+		return ""
+	}
+
+	var rowStyle, cellStyle Style
+	if row%2 == 1 {
+		rowStyle = p.theme.AlternateRow
+	}
+	if p.theme.Cell != nil {
+		cellStyle = p.theme.Cell(row, col, value)
+	}
+	return CombineStyles(rowStyle, p.theme.Columns[columns[col]], cellStyle)
+}
+
+// colorEnabled reports whether colors should be written to w.
+func colorEnabled(w io.Writer, mode ColorMode) bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,140 @@
+package tablr_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestCombineStyles(t *testing.T) {
+	tests := []struct {
+		name   string
+		styles []tablr.Style
+		want   tablr.Style
+	}{
+		{name: "None", want: ""},
+		{name: "Single", styles: []tablr.Style{tablr.StyleBold}, want: "1"},
+		{name: "Multiple", styles: []tablr.Style{tablr.StyleBold, "", tablr.StyleRed, tablr.StyleBgBlue}, want: "1;31;44"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablr.CombineStyles(tt.styles...); got != tt.want {
+				t.Errorf("CombineStyles() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderPlain_Theme(t *testing.T) {
+	theme := tablr.Theme{
+		Header:       tablr.CombineStyles(tablr.StyleBold, tablr.StyleCyan),
+		AlternateRow: tablr.StyleBgGray,
+		Columns:      map[string]tablr.Style{"Name": tablr.StyleGreen},
+		Cell: func(_, _ int, value string) tablr.Style {
+			if strings.HasPrefix(value, "-") {
+				return tablr.StyleRed
+			}
+			return ""
+		},
+	}
+
+	tests := []struct {
+		name    string
+		options []tablr.TableOption
+		want    string
+	}{
+		{
+			name:    "Always",
+			options: []tablr.TableOption{tablr.WithTheme(theme), tablr.WithColor(tablr.ColorAlways)},
+			want: "\x1b[1;36mName \x1b[0m   \x1b[1;36mDelta\x1b[0m\n" +
+				"\x1b[32mweb-1\x1b[0m       3\n" +
+				"\x1b[100;32mweb-2\x1b[0m   \x1b[100;31m   -1\x1b[0m\n",
+		},
+		{
+			name:    "Never",
+			options: []tablr.TableOption{tablr.WithTheme(theme), tablr.WithColor(tablr.ColorNever)},
+			want:    "Name    Delta\nweb-1       3\nweb-2      -1\n",
+		},
+		{
+			name:    "Auto without terminal",
+			options: []tablr.TableOption{tablr.WithTheme(theme)},
+			want:    "Name    Delta\nweb-1       3\nweb-2      -1\n",
+		},
+		{
+			name:    "No theme",
+			options: []tablr.TableOption{tablr.WithColor(tablr.ColorAlways)},
+			want:    "Name    Delta\nweb-1       3\nweb-2      -1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]tablr.TableOption{tablr.WithAlignment(1, tablr.AlignRight)}, tt.options...)
+			table := tablr.New(&bytes.Buffer{}, []string{"Name", "Delta"}, options...)
+			table.AddRows([][]string{{"web-1", "3"}, {"web-2", "-1"}})
+
+			var buf bytes.Buffer
+			if err := table.RenderPlain(&buf); err != nil {
+				t.Fatalf("RenderPlain() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderPlain() got = \n%q, want \n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderBox_Theme(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name"},
+		tablr.WithTheme(tablr.Theme{Header: tablr.StyleBold, Border: tablr.StyleGray}),
+		tablr.WithColor(tablr.ColorAlways),
+	)
+	table.AddRow([]string{"web-1"})
+
+	var buf bytes.Buffer
+	if err := table.RenderBox(&buf, tablr.WithBoxStyle(tablr.BoxASCII)); err != nil {
+		t.Fatalf("RenderBox() error = %v", err)
+	}
+
+	want := "\x1b[90m+-------+\x1b[0m\n" +
+		"\x1b[90m|\x1b[0m\x1b[1m Name  \x1b[0m\x1b[90m|\x1b[0m\n" +
+		"\x1b[90m+-------+\x1b[0m\n" +
+		"\x1b[90m|\x1b[0m web-1 \x1b[90m|\x1b[0m\n" +
+		"\x1b[90m+-------+\x1b[0m\n"
+	if got := buf.String(); got != want {
+		t.Errorf("RenderBox() got = \n%q, want \n%q", got, want)
+	}
+}
+
+func TestTable_RenderPlain_NoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	tests := []struct {
+		name string
+		mode tablr.ColorMode
+		want string
+	}{
+		{name: "Auto", mode: tablr.ColorAuto, want: "Name\n"},
+		{name: "Always", mode: tablr.ColorAlways, want: "\x1b[1mName\x1b[0m\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, []string{"Name"},
+				tablr.WithTheme(tablr.Theme{Header: tablr.StyleBold}),
+				tablr.WithColor(tt.mode),
+			)
+
+			var buf bytes.Buffer
+			if err := table.RenderPlain(&buf); err != nil {
+				t.Fatalf("RenderPlain() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderPlain() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
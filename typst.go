@@ -0,0 +1,122 @@
+package tablr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// typstReplacer escapes the characters that are special in Typst string
+// literals.
+var typstReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// RenderTypst renders the table as a Typst table to the given writer. Cells
+// are written as string literals, so their content is not interpreted as
+// markup. If the table has a caption or label, the table is placed in a
+// figure. Typst labels may only hold letters, digits, "_", "-", "." and ":",
+// so an error is returned, and nothing is written, for any other label.
+func (t *Table) RenderTypst(w io.Writer) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.label != "" && !isTypstLabel(t.label) {
+		return fmt.Errorf("invalid Typst label: %q", t.label)
+	}
+
+	bw := bufio.NewWriter(w)
+	figure := t.caption != "" || t.label != ""
+	indent := "  "
+
+	if figure {
+		bw.WriteString("#figure(\n  table(\n")
+		indent = "    "
+	} else {
+		bw.WriteString("#table(\n")
+	}
+
+	fmt.Fprintf(bw, "%scolumns: %d,\n", indent, len(t.columns))
+	if t.hasAlignments() {
+		aligns := make([]string, len(t.columnAlignments))
+		for i, a := range t.columnAlignments {
+			aligns[i] = typstAlignment(a)
+		}
+		list := strings.Join(aligns, ", ")
+		if len(aligns) == 1 {
+			// A single element array needs a trailing comma.
+			list += ","
+		}
+		fmt.Fprintf(bw, "%salign: (%s),\n", indent, list)
+	}
+
+	header := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		header[i] = typstString(col)
+		if a := t.headerAlignments[i]; a != AlignDefault && a != t.columnAlignments[i] {
+			header[i] = fmt.Sprintf("table.cell(align: %s, %s)", typstAlignment(a), header[i])
+		}
+	}
+	fmt.Fprintf(bw, "%stable.header(%s),\n", indent, strings.Join(header, ", "))
+
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = typstString(cell)
+		}
+		fmt.Fprintf(bw, "%s%s,\n", indent, strings.Join(cells, ", "))
+	}
+
+	if figure {
+		bw.WriteString("  ),\n")
+		if t.caption != "" {
+			fmt.Fprintf(bw, "  caption: %s,\n", typstString(t.caption))
+		}
+		bw.WriteString(")")
+		if t.label != "" {
+			fmt.Fprintf(bw, " <%s>", t.label)
+		}
+		bw.WriteString("\n")
+	} else {
+		bw.WriteString(")\n")
+	}
+
+	return bw.Flush()
+}
+
+// isTypstLabel reports whether s can be used as a Typst label, i.e. written
+// as <s>.
+func isTypstLabel(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_-.:", r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// typstAlignment returns the Typst alignment for the alignment. AlignDefault
+// is written as auto, which leaves the alignment to Typst.
+func typstAlignment(a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return "left"
+	case AlignCenter:
+		return "center"
+	case AlignRight:
+		return "right"
+	}
+	return "auto"
+}
+
+// typstString returns s as a Typst string literal.
+func typstString(s string) string {
+	return `"` + typstReplacer.Replace(s) + `"`
+}
@@ -0,0 +1,130 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderTypst(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		options []tablr.TableOption
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Alignments",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "25", "Los Angeles"},
+			},
+			options: []tablr.TableOption{tablr.WithAlignments(defaultAlignments)},
+			want: `#table(
+  columns: 3,
+  align: (left, center, right),
+  table.header("Name", "Age", "City"),
+  "John Doe", "30", "New York",
+  "Jane Smith", "25", "Los Angeles",
+)
+`,
+		},
+		{
+			name:    "Default alignments",
+			columns: []string{"Name", "Age"},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			want: `#table(
+  columns: 2,
+  table.header("Name", "Age"),
+  "John Doe", "30",
+)
+`,
+		},
+		{
+			name:    "Mixed default alignment and header alignment",
+			columns: []string{"Name", "Age"},
+			rows: [][]string{
+				{"John Doe", "30"},
+			},
+			options: []tablr.TableOption{
+				tablr.WithAlignment(1, tablr.AlignRight),
+				tablr.WithHeaderAlignment(1, tablr.AlignCenter),
+			},
+			want: `#table(
+  columns: 2,
+  align: (auto, right),
+  table.header("Name", table.cell(align: center, "Age")),
+  "John Doe", "30",
+)
+`,
+		},
+		{
+			name:    "Escaping",
+			columns: []string{"Markup"},
+			rows: [][]string{
+				{`*bold* #let x = "y" \ $z$`},
+				{"line 1\nline 2"},
+			},
+			options: []tablr.TableOption{tablr.WithAlignments([]tablr.Alignment{tablr.AlignLeft})},
+			want: `#table(
+  columns: 1,
+  align: (left,),
+  table.header("Markup"),
+  "*bold* #let x = \"y\" \\ $z$",
+  "line 1\nline 2",
+)
+`,
+		},
+		{
+			name:    "Figure with caption and label",
+			columns: []string{"Name"},
+			rows: [][]string{
+				{"John Doe"},
+			},
+			options: []tablr.TableOption{tablr.WithCaption(`People "2024"`, "tab:people")},
+			want: `#figure(
+  table(
+    columns: 1,
+    table.header("Name"),
+    "John Doe",
+  ),
+  caption: "People \"2024\"",
+) <tab:people>
+`,
+		},
+		{
+			name:    "Figure with invalid label",
+			columns: []string{"Name"},
+			options: []tablr.TableOption{tablr.WithCaption("People", "tab people>")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tt.options...)
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			err := table.RenderTypst(&buf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderTypst() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if buf.Len() != 0 {
+					t.Errorf("RenderTypst() wrote %q on error", buf.String())
+				}
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderTypst() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package tablr
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // pad pads a string to the given width with spaces, aligning it as specified.
 func pad(s string, width int, align Alignment) string {
@@ -26,3 +29,82 @@ func pad(s string, width int, align Alignment) string {
 func escapePipes(s string) string {
 	return strings.ReplaceAll(s, "|", "\\|")
 }
+
+// cellWidth returns the width of a string once it has been escaped for use in
+// a Markdown table. For strings spanning multiple lines, the width of the
+// longest line is returned.
+func cellWidth(s string) int {
+	width := 0
+	for _, line := range cellLines(s) {
+		width = max(width, len(escapePipes(line)))
+	}
+	return width
+}
+
+// cellLines splits a cell value into lines.
+func cellLines(s string) []string {
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// isInteger reports whether s is a whole number in decimal notation. Numbers
+// with leading zeros, such as zip codes, are not considered numbers.
+func isInteger(s string) bool {
+	if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+		return false
+	}
+
+	return !hasLeadingZero(strings.TrimLeft(s, "+-"))
+}
+
+// isNumber reports whether s is a number in decimal or exponent notation.
+// Numbers with leading zeros, such as zip codes, are not considered numbers.
+func isNumber(s string) bool {
+	if strings.TrimLeft(s, "+-0123456789.eE") != "" {
+		return false
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return false
+	}
+
+	intPart := strings.TrimLeft(s, "+-")
+	if i := strings.IndexAny(intPart, ".eE"); i >= 0 {
+		intPart = intPart[:i]
+	}
+
+	return !hasLeadingZero(intPart)
+}
+
+// spreadsheetDigits is the number of significant digits kept by spreadsheet
+// applications.
+const spreadsheetDigits = 15
+
+// isSpreadsheetNumber reports whether s is a number that spreadsheet
+// applications keep exactly, i.e. one with at most 15 significant digits.
+// Longer numbers, such as account numbers and 64-bit IDs, would be rounded.
+func isSpreadsheetNumber(s string) bool {
+	if !isNumber(s) {
+		return false
+	}
+
+	mantissa := strings.TrimLeft(s, "+-")
+	if i := strings.IndexAny(mantissa, "eE"); i >= 0 {
+		mantissa = mantissa[:i]
+	}
+	if strings.Contains(mantissa, ".") {
+		mantissa = strings.TrimRight(mantissa, "0")
+	}
+	digits := strings.TrimLeft(strings.Replace(mantissa, ".", "", 1), "0")
+	if len(digits) > spreadsheetDigits {
+		return false
+	}
+
+	// Numbers too small to be represented become zero.
+	v, _ := strconv.ParseFloat(s, 64)
+	return v != 0 || digits == ""
+}
+
+// hasLeadingZero reports whether digits has more than one digit and starts
+// with a zero.
+func hasLeadingZero(digits string) bool {
+	return len(digits) > 1 && digits[0] == '0'
+}
@@ -114,3 +114,40 @@ func TestPad(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNumber(t *testing.T) {
+	tests := []struct {
+		input       string
+		wantInteger bool
+		wantNumber  bool
+	}{
+		{"0", true, true},
+		{"42", true, true},
+		{"-42", true, true},
+		{"+42", true, true},
+		{"3.14", false, true},
+		{"-0.5", false, true},
+		{".5", false, true},
+		{"1e3", false, true},
+		{"007", false, false},
+		{"00.5", false, false},
+		{"", false, false},
+		{"-", false, false},
+		{"1.2.3", false, false},
+		{"NaN", false, false},
+		{"Inf", false, false},
+		{"0x1F", false, false},
+		{"1_000", false, false},
+		{"12abc", false, false},
+		{"99999999999999999999", false, true},
+	}
+
+	for _, tt := range tests {
+		if got := isInteger(tt.input); got != tt.wantInteger {
+			t.Errorf("isInteger(%q) = %v, want %v", tt.input, got, tt.wantInteger)
+		}
+		if got := isNumber(tt.input); got != tt.wantNumber {
+			t.Errorf("isNumber(%q) = %v, want %v", tt.input, got, tt.wantNumber)
+		}
+	}
+}
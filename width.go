@@ -0,0 +1,181 @@
+package tablr
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// wideRanges holds the ranges of characters that take up two columns in a
+// terminal: East Asian wide and fullwidth characters and emoji. The ranges
+// are sorted.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},
+	{0x231A, 0x231B},
+	{0x2329, 0x232A},
+	{0x23E9, 0x23EC},
+	{0x23F0, 0x23F0},
+	{0x23F3, 0x23F3},
+	{0x25FD, 0x25FE},
+	{0x2614, 0x2615},
+	{0x2648, 0x2653},
+	{0x267F, 0x267F},
+	{0x2693, 0x2693},
+	{0x26A1, 0x26A1},
+	{0x26AA, 0x26AB},
+	{0x26BD, 0x26BE},
+	{0x26C4, 0x26C5},
+	{0x26CE, 0x26CE},
+	{0x26D4, 0x26D4},
+	{0x26EA, 0x26EA},
+	{0x26F2, 0x26F3},
+	{0x26F5, 0x26F5},
+	{0x26FA, 0x26FA},
+	{0x26FD, 0x26FD},
+	{0x2705, 0x2705},
+	{0x270A, 0x270B},
+	{0x2728, 0x2728},
+	{0x274C, 0x274C},
+	{0x274E, 0x274E},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2795, 0x2797},
+	{0x27B0, 0x27B0},
+	{0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50},
+	{0x2B55, 0x2B55},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xA960, 0xA97F},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x16FE0, 0x16FE4},
+	{0x17000, 0x18CFF},
+	{0x1B000, 0x1B2FF},
+	{0x1F004, 0x1F004},
+	{0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A},
+	{0x1F200, 0x1F251},
+	{0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335},
+	{0x1F337, 0x1F37C},
+	{0x1F37E, 0x1F393},
+	{0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3},
+	{0x1F3E0, 0x1F3F0},
+	{0x1F3F4, 0x1F3F4},
+	{0x1F3F8, 0x1F43E},
+	{0x1F440, 0x1F440},
+	{0x1F442, 0x1F4FC},
+	{0x1F4FF, 0x1F53D},
+	{0x1F54B, 0x1F54E},
+	{0x1F550, 0x1F567},
+	{0x1F57A, 0x1F57A},
+	{0x1F595, 0x1F596},
+	{0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F},
+	{0x1F680, 0x1F6C5},
+	{0x1F6CC, 0x1F6CC},
+	{0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7},
+	{0x1F6EB, 0x1F6EC},
+	{0x1F6F4, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB},
+	{0x1F90C, 0x1F93A},
+	{0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF},
+	{0x1FA70, 0x1FAFF},
+	{0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
+
+// runeWidth returns the number of columns r takes up in a terminal.
+// Combining marks, format characters and control characters take up no
+// columns.
+func runeWidth(r rune) int {
+	if r == 0 || unicode.IsControl(r) || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+
+	i := sort.Search(len(wideRanges), func(i int) bool {
+		return wideRanges[i][1] >= r
+	})
+	if i < len(wideRanges) && wideRanges[i][0] <= r {
+		return 2
+	}
+	return 1
+}
+
+// displayWidth returns the number of columns s takes up in a terminal. For
+// strings spanning multiple lines, the width of the longest line is returned.
+func displayWidth(s string) int {
+	width := 0
+	for _, line := range cellLines(s) {
+		lineWidth := 0
+		for _, r := range line {
+			lineWidth += runeWidth(r)
+		}
+		width = max(width, lineWidth)
+	}
+	return width
+}
+
+// displayWidths returns the width of each column, measured as by
+// displayWidth, which is the width of its widest cell or header.
+func displayWidths(header []string, rows [][]string) []int {
+	widths := make([]int, len(header))
+	for i, col := range header {
+		widths[i] = displayWidth(col)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+	return widths
+}
+
+// columnDisplayWidths returns the widths of the columns of the table as
+// measured by displayWidth, honoring minimum widths set on the table, e.g.
+// using SetColumnMinWidth. As columnMinWidths also grows to fit the cells,
+// measured in bytes, it only counts where it exceeds that.
+func (t *Table) columnDisplayWidths() []int {
+	widths := displayWidths(t.columns, t.rows)
+	for i, col := range t.columns {
+		natural := cellWidth(col)
+		for _, row := range t.rows {
+			natural = max(natural, cellWidth(row[i]))
+		}
+		if t.columnMinWidths[i] > natural {
+			widths[i] = max(widths[i], t.columnMinWidths[i])
+		}
+	}
+	return widths
+}
+
+// padDisplay is like pad, but measures s by the number of columns it takes up
+// in a terminal rather than by its length in bytes.
+func padDisplay(s string, width int, align Alignment) string {
+	padding := width - displayWidth(s)
+	if padding <= 0 {
+		return s
+	}
+
+	switch align {
+	case AlignCenter:
+		left := padding / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", padding-left)
+	case AlignRight:
+		return strings.Repeat(" ", padding) + s
+	}
+	return s + strings.Repeat(" ", padding)
+}
@@ -0,0 +1,54 @@
+package tablr
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "Empty", input: "", want: 0},
+		{name: "ASCII", input: "hello", want: 5},
+		{name: "Latin accents", input: "café", want: 4},
+		{name: "Combining mark", input: "cafe\u0301", want: 4},
+		{name: "CJK", input: "日本語", want: 6},
+		{name: "Hangul", input: "한국", want: 4},
+		{name: "Fullwidth", input: "ＡＢ", want: 4},
+		{name: "Emoji", input: "ok 🚀", want: 5},
+		{name: "Zero width joiner", input: "a\u200db", want: 2},
+		{name: "Multiple lines", input: "ab\n日本語", want: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := displayWidth(tt.input); got != tt.want {
+				t.Errorf("displayWidth(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPadDisplay(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		align Alignment
+		want  string
+	}{
+		{name: "Left", input: "日本", width: 6, align: AlignLeft, want: "日本  "},
+		{name: "Default", input: "日本", width: 6, align: AlignDefault, want: "日本  "},
+		{name: "Center", input: "日本", width: 7, align: AlignCenter, want: " 日本  "},
+		{name: "Right", input: "日本", width: 6, align: AlignRight, want: "  日本"},
+		{name: "Too wide", input: "日本語", width: 4, align: AlignRight, want: "日本語"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := padDisplay(tt.input, tt.width, tt.align); got != tt.want {
+				t.Errorf("padDisplay(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
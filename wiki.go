@@ -0,0 +1,157 @@
+package tablr
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// MediaWikiOption represents an option for configuring MediaWiki output.
+type MediaWikiOption func(*mediaWikiConfig)
+
+type mediaWikiConfig struct {
+	class string
+}
+
+// WithMediaWikiClass sets the CSS class of the table. The default is
+// "wikitable". An empty class omits the attribute.
+func WithMediaWikiClass(class string) MediaWikiOption {
+	return func(c *mediaWikiConfig) {
+		c.class = class
+	}
+}
+
+// mediaWikiReplacer escapes characters that have a meaning in MediaWiki
+// markup using HTML character references, and turns line breaks into <br />.
+var mediaWikiReplacer = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	"|", "&#124;",
+	"!", "&#33;",
+	"[", "&#91;",
+	"]", "&#93;",
+	"{", "&#123;",
+	"}", "&#125;",
+	"'", "&#39;",
+	"~", "&#126;",
+	"\r\n", "<br />",
+	"\n", "<br />",
+)
+
+// jiraReplacer escapes characters that have a meaning in Jira and Confluence
+// wiki markup, and turns line breaks into \\.
+var jiraReplacer = strings.NewReplacer(
+	`\`, `&#92;`,
+	`|`, `\|`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`*`, `\*`,
+	`_`, `\_`,
+	`-`, `\-`,
+	`+`, `\+`,
+	`^`, `\^`,
+	`~`, `\~`,
+	`?`, `\?`,
+	`!`, `\!`,
+	"\r\n", `\\`,
+	"\n", `\\`,
+)
+
+// RenderMediaWiki renders the table as a MediaWiki table to the given writer.
+// Column alignments are written as text-align styles.
+func (t *Table) RenderMediaWiki(w io.Writer, opts ...MediaWikiOption) error {
+	c := &mediaWikiConfig{
+		class: "wikitable",
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("{|")
+	if c.class != "" {
+		bw.WriteString(` class="` + strings.ReplaceAll(c.class, `"`, "&quot;") + `"`)
+	}
+	bw.WriteString("\n")
+
+	bw.WriteString("|-\n")
+	for i, col := range t.columns {
+		writeMediaWikiCell(bw, "!", col, t.headerAlignments[i])
+	}
+
+	for _, row := range t.rows {
+		bw.WriteString("|-\n")
+		for i, cell := range row {
+			writeMediaWikiCell(bw, "|", cell, t.columnAlignments[i])
+		}
+	}
+
+	bw.WriteString("|}\n")
+
+	return bw.Flush()
+}
+
+// writeMediaWikiCell writes a header or data cell on a line of its own.
+func writeMediaWikiCell(w *bufio.Writer, marker, value string, alignment Alignment) {
+	w.WriteString(marker)
+	if style := mediaWikiStyle(alignment); style != "" {
+		w.WriteString(` style="` + style + `" |`)
+	}
+	if value != "" {
+		w.WriteString(" " + mediaWikiReplacer.Replace(value))
+	}
+	w.WriteString("\n")
+}
+
+// mediaWikiStyle returns the CSS style for the alignment, or an empty string
+// for AlignDefault.
+func mediaWikiStyle(a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return "text-align:left"
+	case AlignCenter:
+		return "text-align:center"
+	case AlignRight:
+		return "text-align:right"
+	}
+	return ""
+}
+
+// RenderJira renders the table using Jira and Confluence wiki markup to the
+// given writer. The markup has no syntax for alignment, so the column
+// alignments are not used.
+func (t *Table) RenderJira(w io.Writer) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+
+	writeJiraRow(bw, "||", t.columns)
+	for _, row := range t.rows {
+		writeJiraRow(bw, "|", row)
+	}
+
+	return bw.Flush()
+}
+
+// writeJiraRow writes a row using sep to delimit the cells. Empty cells are
+// written as a single space, as two adjacent pipes would start a header cell.
+func writeJiraRow(w *bufio.Writer, sep string, row []string) {
+	w.WriteString(sep)
+	for _, cell := range row {
+		if cell == "" {
+			cell = " "
+		}
+		w.WriteString(jiraReplacer.Replace(cell))
+		w.WriteString(sep)
+	}
+	w.WriteString("\n")
+}
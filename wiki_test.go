@@ -0,0 +1,136 @@
+package tablr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+func TestTable_RenderMediaWiki(t *testing.T) {
+	tests := []struct {
+		name       string
+		columns    []string
+		rows       [][]string
+		alignments []tablr.Alignment
+		options    []tablr.MediaWikiOption
+		want       string
+	}{
+		{
+			name:       "Aligned table",
+			columns:    []string{"Name", "Age", "City"},
+			alignments: []tablr.Alignment{tablr.AlignDefault, tablr.AlignCenter, tablr.AlignRight},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "", "Los Angeles"},
+			},
+			want: `{| class="wikitable"
+|-
+! Name
+! style="text-align:center" | Age
+! style="text-align:right" | City
+|-
+| John Doe
+| style="text-align:center" | 30
+| style="text-align:right" | New York
+|-
+| Jane Smith
+| style="text-align:center" |
+| style="text-align:right" | Los Angeles
+|}
+`,
+		},
+		{
+			name:    "Escaping",
+			columns: []string{"A!!B"},
+			rows: [][]string{
+				{"x || y"},
+				{"-1"},
+				{"[[Link]] {{T}} '''b''' <i>&~~~~"},
+				{"two\nlines"},
+			},
+			options: []tablr.MediaWikiOption{tablr.WithMediaWikiClass("")},
+			want: `{|
+|-
+! A&#33;&#33;B
+|-
+| x &#124;&#124; y
+|-
+| -1
+|-
+| &#91;&#91;Link&#93;&#93; &#123;&#123;T&#125;&#125; &#39;&#39;&#39;b&#39;&#39;&#39; &lt;i&gt;&amp;&#126;&#126;&#126;&#126;
+|-
+| two<br />lines
+|}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns, tablr.WithAlignments(tt.alignments))
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			if err := table.RenderMediaWiki(&buf, tt.options...); err != nil {
+				t.Fatalf("RenderMediaWiki() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderMediaWiki() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderJira(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		rows    [][]string
+		want    string
+	}{
+		{
+			name:    "Simple table",
+			columns: []string{"Name", "Age", "City"},
+			rows: [][]string{
+				{"John Doe", "30", "New York"},
+				{"Jane Smith", "", "Los Angeles"},
+			},
+			want: `||Name||Age||City||
+|John Doe|30|New York|
+|Jane Smith| |Los Angeles|
+`,
+		},
+		{
+			name:    "Escaping",
+			columns: []string{"Markup"},
+			rows: [][]string{
+				{"a | b"},
+				{"{code} [link] *bold* _it_ -del- +ins+ ^sup^ ~sub~ ??cite?? !img!"},
+				{`C:\temp`},
+				{"two\nlines"},
+			},
+			want: `||Markup||
+|a \| b|
+|\{code\} \[link\] \*bold\* \_it\_ \-del\- \+ins\+ \^sup\^ \~sub\~ \?\?cite\?\? \!img\!|
+|C:&#92;temp|
+|two\\lines|
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tablr.New(&bytes.Buffer{}, tt.columns)
+			table.AddRows(tt.rows)
+
+			var buf bytes.Buffer
+			if err := table.RenderJira(&buf); err != nil {
+				t.Fatalf("RenderJira() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("RenderJira() got = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
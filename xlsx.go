@@ -0,0 +1,663 @@
+package tablr
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// XLSXOption represents an option for configuring XLSX output.
+type XLSXOption func(*xlsxConfig)
+
+type xlsxConfig struct {
+	sheetName    string
+	freezeHeader bool
+	autoFilter   bool
+}
+
+// WithXLSXSheetName sets the name of the sheet. The default is "Sheet1".
+func WithXLSXSheetName(name string) XLSXOption {
+	return func(c *xlsxConfig) {
+		c.sheetName = name
+	}
+}
+
+// WithXLSXFreezeHeader sets whether the header row is frozen, so that it stays
+// visible when scrolling. The header row is frozen by default.
+func WithXLSXFreezeHeader(freeze bool) XLSXOption {
+	return func(c *xlsxConfig) {
+		c.freezeHeader = freeze
+	}
+}
+
+// WithXLSXAutoFilter sets whether the header row gets filter buttons. The
+// filter is added by default.
+func WithXLSXAutoFilter(autoFilter bool) XLSXOption {
+	return func(c *xlsxConfig) {
+		c.autoFilter = autoFilter
+	}
+}
+
+const (
+	xlsxMainNamespace = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xlsxRelNamespace  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+)
+
+// xlsxStaticFiles holds the parts of the workbook that do not depend on the
+// table.
+var xlsxStaticFiles = []struct {
+	name, content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelNamespace + `/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelNamespace + `/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="` + xlsxRelNamespace + `/styles" Target="styles.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/styles.xml",
+		content: xml.Header + `<styleSheet xmlns="` + xlsxMainNamespace + `">` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="8">` + xlsxCellFormats() + `</cellXfs>` +
+			`</styleSheet>`,
+	},
+}
+
+// xlsxCellFormats returns the cell formats referenced by xlsxStyle: one for
+// each combination of font weight and alignment.
+func xlsxCellFormats() string {
+	var sb strings.Builder
+	for fontID := range 2 {
+		for a := AlignDefault; a <= AlignRight; a++ {
+			fmt.Fprintf(&sb, `<xf numFmtId="0" fontId="%d" fillId="0" borderId="0" xfId="0"`, fontID)
+			if h := xlsxAlignment(a); h != "" {
+				fmt.Fprintf(&sb, ` applyAlignment="1"><alignment horizontal="%s"/></xf>`, h)
+			} else {
+				sb.WriteString(`/>`)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// xlsxStyle returns the index of the cell format for the font weight and
+// alignment.
+func xlsxStyle(bold bool, a Alignment) int {
+	if bold {
+		return 4 + int(a)
+	}
+	return int(a)
+}
+
+// xlsxAlignment returns the horizontal alignment of a cell format, or an
+// empty string for Excel's default, which aligns text left and numbers right.
+func xlsxAlignment(a Alignment) string {
+	switch a {
+	case AlignLeft:
+		return "left"
+	case AlignCenter:
+		return "center"
+	case AlignRight:
+		return "right"
+	}
+	return ""
+}
+
+// RenderXLSX renders the table as an Excel workbook with a single sheet to
+// the given writer. The header row is bold, and cells holding numbers are
+// stored as numbers.
+func (t *Table) RenderXLSX(w io.Writer, opts ...XLSXOption) error {
+	c := &xlsxConfig{
+		sheetName:    "Sheet1",
+		freezeHeader: true,
+		autoFilter:   true,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if err := validateSheetName(c.sheetName); err != nil {
+		return err
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	zw := zip.NewWriter(w)
+
+	for _, f := range xlsxStaticFiles {
+		if err := writeZipFile(zw, f.name, f.content); err != nil {
+			return err
+		}
+	}
+	if err := writeZipFile(zw, "xl/workbook.xml", t.xlsxWorkbook(c)); err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "xl/worksheets/sheet1.xml", t.xlsxSheet(c)); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// xlsxWorkbook returns the workbook part.
+func (t *Table) xlsxWorkbook(c *xlsxConfig) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, `<workbook xmlns="%s" xmlns:r="%s"><sheets>`, xlsxMainNamespace, xlsxRelNamespace)
+	fmt.Fprintf(&sb, `<sheet name="%s" sheetId="1" r:id="rId1"/></sheets>`, escapeXML(c.sheetName))
+	if c.autoFilter && len(t.columns) > 0 {
+		name := "'" + strings.ReplaceAll(c.sheetName, "'", "''") + "'"
+		fmt.Fprintf(&sb, `<definedNames><definedName name="_xlnm._FilterDatabase" localSheetId="0" hidden="1">%s!$A$1:$%s$%d</definedName></definedNames>`,
+			escapeXML(name), columnName(len(t.columns)-1), len(t.rows)+1)
+	}
+	sb.WriteString(`</workbook>`)
+	return sb.String()
+}
+
+// xlsxSheet returns the worksheet part holding the table.
+func (t *Table) xlsxSheet(c *xlsxConfig) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, `<worksheet xmlns="%s" xmlns:r="%s">`, xlsxMainNamespace, xlsxRelNamespace)
+
+	if c.freezeHeader {
+		sb.WriteString(`<sheetViews><sheetView workbookViewId="0">` +
+			`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>` +
+			`<selection pane="bottomLeft" activeCell="A2" sqref="A2"/>` +
+			`</sheetView></sheetViews>`)
+	}
+
+	if len(t.columns) > 0 {
+		sb.WriteString(`<cols>`)
+		for i, width := range t.columnMinWidths {
+			fmt.Fprintf(&sb, `<col min="%d" max="%[1]d" width="%d" customWidth="1"/>`, i+1, width+2)
+		}
+		sb.WriteString(`</cols>`)
+	}
+
+	sb.WriteString(`<sheetData>`)
+	writeXLSXRow(&sb, 1, t.columns, func(col int) int {
+		return xlsxStyle(true, t.headerAlignments[col])
+	}, false)
+	for i, row := range t.rows {
+		writeXLSXRow(&sb, i+2, row, func(col int) int {
+			return xlsxStyle(false, t.columnAlignments[col])
+		}, true)
+	}
+	sb.WriteString(`</sheetData>`)
+
+	if c.autoFilter && len(t.columns) > 0 {
+		fmt.Fprintf(&sb, `<autoFilter ref="A1:%s%d"/>`, columnName(len(t.columns)-1), len(t.rows)+1)
+	}
+
+	sb.WriteString(`</worksheet>`)
+	return sb.String()
+}
+
+// writeXLSXRow writes a row of cells. Empty cells are left out. If numbers is
+// true, cells holding numbers that can be stored exactly are stored as
+// numbers.
+func writeXLSXRow(sb *strings.Builder, r int, row []string, styleOf func(col int) int, numbers bool) {
+	fmt.Fprintf(sb, `<row r="%d">`, r)
+	for col, cell := range row {
+		if cell == "" {
+			continue
+		}
+		ref := columnName(col) + strconv.Itoa(r)
+		if numbers && isSpreadsheetNumber(cell) {
+			fmt.Fprintf(sb, `<c r="%s" s="%d"><v>%s</v></c>`, ref, styleOf(col), cell)
+			continue
+		}
+		fmt.Fprintf(sb, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, styleOf(col), escapeXML(cell))
+	}
+	sb.WriteString(`</row>`)
+}
+
+// columnName returns the spreadsheet name of the column with the given
+// zero-based index, e.g. A for 0 and AA for 26.
+func columnName(index int) string {
+	var name []byte
+	for n := index + 1; n > 0; n = (n - 1) / 26 {
+		name = append([]byte{byte('A' + (n-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+// validateSheetName reports an error if name cannot be used as the name of
+// a sheet.
+func validateSheetName(name string) error {
+	if name == "" {
+		return errors.New("sheet name is empty")
+	}
+	if len([]rune(name)) > 31 {
+		return fmt.Errorf("sheet name %q is longer than 31 characters", name)
+	}
+	if strings.ContainsAny(name, `[]:*?/\`) {
+		return fmt.Errorf("sheet name %q contains invalid characters", name)
+	}
+	return nil
+}
+
+// writeZipFile adds a file with the given content to the archive.
+func writeZipFile(zw *zip.Writer, name, content string) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, content)
+	return err
+}
+
+// xlsxHeaderSearchRows is the number of non-empty rows searched when
+// detecting the header row of a sheet.
+const xlsxHeaderSearchRows = 10
+
+// xlsxText holds an entry of the shared strings table or an inline string,
+// made up of either plain text or rich text runs.
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+// String returns the text without formatting.
+func (x *xlsxText) String() string {
+	if x == nil {
+		return ""
+	}
+	if len(x.Runs) == 0 {
+		return x.Text
+	}
+	var sb strings.Builder
+	for _, r := range x.Runs {
+		sb.WriteString(r.Text)
+	}
+	return sb.String()
+}
+
+// xlsxRelationship holds a relationship of a workbook to one of its parts.
+type xlsxRelationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:"Type,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+// xlsxSheet holds the name of a sheet and the ID of its relationship.
+type xlsxSheet struct {
+	Name string `xml:"name,attr"`
+	RID  string `xml:"id,attr"`
+}
+
+// xlsxWorksheet holds the cells of a worksheet.
+type xlsxWorksheet struct {
+	Rows []struct {
+		Num   int `xml:"r,attr"`
+		Cells []struct {
+			Ref    string    `xml:"r,attr"`
+			Type   string    `xml:"t,attr"`
+			Value  string    `xml:"v"`
+			Inline *xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// xlsxValue holds the text of a cell and whether it is a number.
+type xlsxValue struct {
+	text    string
+	numeric bool
+}
+
+// FromXLSX creates a new table from a sheet of an Excel workbook. Shared
+// strings, inline strings, numbers and booleans are read as text, and columns
+// holding only numbers are right-aligned. Fully empty rows are skipped.
+//
+// The sheet is selected using WithSheetName or WithSheetIndex, and can be
+// restricted to a range of cells using WithCellRange. The header row is
+// detected, unless set using WithHeaderRow. Empty headers are named after
+// their column, e.g. "C".
+func FromXLSX(r io.Reader, opts ...ImportOption) (*Table, error) {
+	c := newImportConfig(opts)
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("reading workbook: %w", err)
+	}
+
+	sheetPath, sharedPath, err := c.xlsxPaths(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared []string
+	if sharedPath != "" {
+		var sst struct {
+			Items []xlsxText `xml:"si"`
+		}
+		if err := readZipXML(zr, sharedPath, &sst); err != nil {
+			return nil, err
+		}
+		for i := range sst.Items {
+			shared = append(shared, sst.Items[i].String())
+		}
+	}
+
+	var sheet xlsxWorksheet
+	if err := readZipXML(zr, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	cells, err := xlsxCells(&sheet, shared)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.xlsxTable(cells)
+}
+
+// xlsxPaths returns the paths within the archive of the selected sheet and,
+// if the workbook has one, of the shared strings table.
+func (c *importConfig) xlsxPaths(zr *zip.Reader) (sheetPath, sharedPath string, err error) {
+	var workbook struct {
+		Sheets []xlsxSheet `xml:"sheets>sheet"`
+	}
+	if err := readZipXML(zr, "xl/workbook.xml", &workbook); err != nil {
+		return "", "", err
+	}
+
+	var rels struct {
+		Relationships []xlsxRelationship `xml:"Relationship"`
+	}
+	if err := readZipXML(zr, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", "", err
+	}
+
+	index := c.sheetIndex
+	if c.sheetName != "" {
+		index = slices.IndexFunc(workbook.Sheets, func(s xlsxSheet) bool {
+			return s.Name == c.sheetName
+		})
+		if index < 0 {
+			return "", "", fmt.Errorf("sheet %q not found", c.sheetName)
+		}
+	}
+	if index < 0 || index >= len(workbook.Sheets) {
+		return "", "", fmt.Errorf("sheet index %d out of range", index)
+	}
+
+	for _, rel := range rels.Relationships {
+		target := strings.TrimPrefix(rel.Target, "/")
+		if !strings.HasPrefix(rel.Target, "/") {
+			target = path.Join("xl", rel.Target)
+		}
+		switch {
+		case rel.ID == workbook.Sheets[index].RID:
+			sheetPath = target
+		case strings.HasSuffix(rel.Type, "/sharedStrings"):
+			sharedPath = target
+		}
+	}
+	if sheetPath == "" {
+		return "", "", fmt.Errorf("sheet %q has no worksheet", workbook.Sheets[index].Name)
+	}
+
+	return sheetPath, sharedPath, nil
+}
+
+// xlsxCells returns the values of the non-empty cells of a worksheet by
+// one-based row number and zero-based column index.
+func xlsxCells(sheet *xlsxWorksheet, shared []string) (map[int]map[int]xlsxValue, error) {
+	cells := make(map[int]map[int]xlsxValue)
+
+	rowNum := 0
+	for _, row := range sheet.Rows {
+		rowNum++
+		if row.Num > 0 {
+			rowNum = row.Num
+		}
+
+		col := -1
+		for _, cell := range row.Cells {
+			col++
+			if cell.Ref != "" {
+				var err error
+				if col, _, err = parseCellRef(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+
+			var v xlsxValue
+			switch cell.Type {
+			case "s":
+				i, err := strconv.Atoi(cell.Value)
+				if err != nil || i < 0 || i >= len(shared) {
+					return nil, fmt.Errorf("cell %s: invalid shared string index %q", cell.Ref, cell.Value)
+				}
+				v.text = shared[i]
+			case "inlineStr":
+				v.text = cell.Inline.String()
+			case "b":
+				v.text = "FALSE"
+				if cell.Value == "1" {
+					v.text = "TRUE"
+				}
+			case "str", "e":
+				v.text = cell.Value
+			default:
+				v.text = xlsxNumber(cell.Value)
+				v.numeric = true
+			}
+
+			if v.text == "" {
+				continue
+			}
+			if cells[rowNum] == nil {
+				cells[rowNum] = make(map[int]xlsxValue)
+			}
+			cells[rowNum][col] = v
+		}
+	}
+
+	return cells, nil
+}
+
+// xlsxTable creates the table from the cells within the configured range,
+// starting at the header row.
+func (c *importConfig) xlsxTable(cells map[int]map[int]xlsxValue) (*Table, error) {
+	minCol, minRow, maxCol, maxRow := 0, 1, -1, 0
+	if c.cellRange != "" {
+		var err error
+		if minCol, minRow, maxCol, maxRow, err = parseCellRange(c.cellRange); err != nil {
+			return nil, err
+		}
+	}
+
+	var rowNums []int
+	for num := range cells {
+		if num >= minRow && (c.cellRange == "" || num <= maxRow) {
+			rowNums = append(rowNums, num)
+		}
+	}
+	slices.Sort(rowNums)
+
+	inRange := func(col int) bool {
+		return col >= minCol && (c.cellRange == "" || col <= maxCol)
+	}
+	count := func(num int, textOnly bool) int {
+		n := 0
+		for col, v := range cells[num] {
+			if inRange(col) && (!textOnly || !v.numeric) {
+				n++
+			}
+		}
+		return n
+	}
+
+	header := c.headerRow
+	switch {
+	case header > 0:
+	case c.cellRange != "":
+		header = minRow
+	default:
+		best := 0
+		for i, num := range rowNums {
+			if i == xlsxHeaderSearchRows {
+				break
+			}
+			if n := count(num, true); n > best {
+				header, best = num, n
+			}
+		}
+	}
+
+	if c.cellRange == "" {
+		minCol = -1
+		for _, num := range rowNums {
+			if num < header {
+				continue
+			}
+			for col := range cells[num] {
+				if minCol < 0 || col < minCol {
+					minCol = col
+				}
+				maxCol = max(maxCol, col)
+			}
+		}
+		minCol = max(minCol, 0)
+	}
+
+	columns := make([]string, 0, maxCol-minCol+1)
+	for col := minCol; col <= maxCol; col++ {
+		name := cells[header][col].text
+		if name == "" {
+			name = columnName(col)
+		}
+		columns = append(columns, name)
+	}
+
+	numeric := make([]bool, len(columns))
+	for i := range numeric {
+		numeric[i] = true
+	}
+	seen := make([]bool, len(columns))
+
+	var rows [][]string
+	for _, num := range rowNums {
+		if num <= header || count(num, false) == 0 {
+			continue
+		}
+		row := make([]string, len(columns))
+		for i := range columns {
+			v, ok := cells[num][minCol+i]
+			if !ok {
+				continue
+			}
+			row[i] = v.text
+			seen[i] = true
+			numeric[i] = numeric[i] && v.numeric
+		}
+		rows = append(rows, row)
+	}
+
+	alignments := make([]Alignment, len(columns))
+	for i := range alignments {
+		if seen[i] && numeric[i] {
+			alignments[i] = AlignRight
+		}
+	}
+
+	return c.newTable(columns, rows, alignments), nil
+}
+
+// xlsxNumber formats a number stored in a cell with the 15 significant digits
+// shown by spreadsheet applications, e.g. 0.1+0.2 as 0.3.
+func xlsxNumber(v string) string {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v
+	}
+	return strconv.FormatFloat(f, 'g', spreadsheetDigits, 64)
+}
+
+// parseCellRef parses a cell reference such as "B12" into its zero-based
+// column index and one-based row number.
+func parseCellRef(ref string) (col, row int, err error) {
+	ref = strings.ToUpper(strings.ReplaceAll(ref, "$", ""))
+	digits := strings.TrimLeft(ref, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	letters := ref[:len(ref)-len(digits)]
+	if letters == "" || len(letters) > 3 {
+		return 0, 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	for _, r := range letters {
+		col = col*26 + int(r-'A') + 1
+	}
+	row, err = strconv.Atoi(digits)
+	if err != nil || row < 1 {
+		return 0, 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return col - 1, row, nil
+}
+
+// parseCellRange parses a range of cells such as "B2:E20".
+func parseCellRange(cellRange string) (minCol, minRow, maxCol, maxRow int, err error) {
+	from, to, ok := strings.Cut(cellRange, ":")
+	if !ok {
+		return 0, 0, 0, 0, fmt.Errorf("invalid cell range %q", cellRange)
+	}
+	col1, row1, err := parseCellRef(from)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	col2, row2, err := parseCellRef(to)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return min(col1, col2), min(row1, row2), max(col1, col2), max(row1, row2), nil
+}
+
+// readZipXML decodes the XML file with the given name in the archive into v.
+func readZipXML(zr *zip.Reader, name string, v any) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := xml.NewDecoder(f).Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package tablr_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/KimNorgaard/tablr"
+)
+
+type xlsxTestSheet struct {
+	Pane *struct {
+		YSplit string `xml:"ySplit,attr"`
+		State  string `xml:"state,attr"`
+	} `xml:"sheetViews>sheetView>pane"`
+	Cols []struct {
+		Min   string `xml:"min,attr"`
+		Width string `xml:"width,attr"`
+	} `xml:"cols>col"`
+	Rows []struct {
+		R     string `xml:"r,attr"`
+		Cells []struct {
+			R      string `xml:"r,attr"`
+			S      string `xml:"s,attr"`
+			T      string `xml:"t,attr"`
+			V      string `xml:"v"`
+			Inline string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+	AutoFilter *struct {
+		Ref string `xml:"ref,attr"`
+	} `xml:"autoFilter"`
+}
+
+func readZipFile(t *testing.T, data []byte, name string) []byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	f, err := zr.Open(name)
+	if err != nil {
+		t.Fatalf("Open(%q) error = %v", name, err)
+	}
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll(%q) error = %v", name, err)
+	}
+	return b
+}
+
+func TestTable_RenderXLSX(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age", "City"}, tablr.WithAlignments(defaultAlignments))
+	table.AddRows([][]string{
+		{"John <Doe>", "30", "New York"},
+		{"Jane Smith", "", "02134"},
+		{"Max", "1.5e3", " padded "},
+	})
+
+	var buf bytes.Buffer
+	if err := table.RenderXLSX(&buf); err != nil {
+		t.Fatalf("RenderXLSX() error = %v", err)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		if !bytes.HasPrefix(readZipFile(t, buf.Bytes(), name), []byte(xml.Header)) {
+			t.Errorf("RenderXLSX() %s has no XML header", name)
+		}
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+		DefinedName string `xml:"definedNames>definedName"`
+	}
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "xl/workbook.xml"), &workbook); err != nil {
+		t.Fatalf("Unmarshal(workbook) error = %v", err)
+	}
+	if len(workbook.Sheets) != 1 || workbook.Sheets[0].Name != "Sheet1" {
+		t.Errorf("RenderXLSX() sheets = %+v, want Sheet1", workbook.Sheets)
+	}
+	if want := "'Sheet1'!$A$1:$C$4"; workbook.DefinedName != want {
+		t.Errorf("RenderXLSX() defined name = %q, want %q", workbook.DefinedName, want)
+	}
+
+	var sheet xlsxTestSheet
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "xl/worksheets/sheet1.xml"), &sheet); err != nil {
+		t.Fatalf("Unmarshal(sheet) error = %v", err)
+	}
+
+	if sheet.Pane == nil || sheet.Pane.YSplit != "1" || sheet.Pane.State != "frozen" {
+		t.Errorf("RenderXLSX() pane = %+v, want frozen header", sheet.Pane)
+	}
+	if sheet.AutoFilter == nil || sheet.AutoFilter.Ref != "A1:C4" {
+		t.Errorf("RenderXLSX() autoFilter = %+v, want A1:C4", sheet.AutoFilter)
+	}
+
+	var widths []string
+	for _, col := range sheet.Cols {
+		widths = append(widths, col.Width)
+	}
+	if want := []string{"12", "7", "10"}; !equalSlices(widths, want) {
+		t.Errorf("RenderXLSX() widths = %v, want %v", widths, want)
+	}
+
+	type cell struct{ ref, style, typ, value string }
+	var got []cell
+	for _, row := range sheet.Rows {
+		for _, c := range row.Cells {
+			value := c.V
+			if c.T == "inlineStr" {
+				value = c.Inline
+			}
+			got = append(got, cell{c.R, c.S, c.T, value})
+		}
+	}
+	want := []cell{
+		{"A1", "5", "inlineStr", "Name"},
+		{"B1", "6", "inlineStr", "Age"},
+		{"C1", "7", "inlineStr", "City"},
+		{"A2", "1", "inlineStr", "John <Doe>"},
+		{"B2", "2", "", "30"},
+		{"C2", "3", "inlineStr", "New York"},
+		{"A3", "1", "inlineStr", "Jane Smith"},
+		{"C3", "3", "inlineStr", "02134"},
+		{"A4", "1", "inlineStr", "Max"},
+		{"B4", "2", "", "1.5e3"},
+		{"C4", "3", "inlineStr", " padded "},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RenderXLSX() cells = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("RenderXLSX() cell %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTable_RenderXLSX_Options(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name"})
+	table.AddRow([]string{"John"})
+
+	var buf bytes.Buffer
+	err := table.RenderXLSX(&buf,
+		tablr.WithXLSXSheetName("People's"),
+		tablr.WithXLSXFreezeHeader(false),
+		tablr.WithXLSXAutoFilter(false),
+	)
+	if err != nil {
+		t.Fatalf("RenderXLSX() error = %v", err)
+	}
+
+	var sheet xlsxTestSheet
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "xl/worksheets/sheet1.xml"), &sheet); err != nil {
+		t.Fatalf("Unmarshal(sheet) error = %v", err)
+	}
+	if sheet.Pane != nil {
+		t.Errorf("RenderXLSX() pane = %+v, want none", sheet.Pane)
+	}
+	if sheet.AutoFilter != nil {
+		t.Errorf("RenderXLSX() autoFilter = %+v, want none", sheet.AutoFilter)
+	}
+	if !bytes.Contains(readZipFile(t, buf.Bytes(), "xl/workbook.xml"), []byte(`name="People&#39;s"`)) {
+		t.Errorf("RenderXLSX() workbook does not contain the sheet name")
+	}
+
+	for _, name := range []string{"", "a/b", "[x]", "this sheet name is far too long!"} {
+		if err := table.RenderXLSX(io.Discard, tablr.WithXLSXSheetName(name)); err == nil {
+			t.Errorf("RenderXLSX() with sheet name %q error = nil, want error", name)
+		}
+	}
+}
+
+// newTestWorkbook returns a workbook with the given sheets, a shared strings
+// table and relationships using absolute and relative targets.
+func newTestWorkbook(t *testing.T, shared string, sheets map[string]string, order []string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	write := func(name, content string) {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(f, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	workbook := `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`
+	rels := `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rIdS" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="/xl/sharedStrings.xml"/>`
+	for i, name := range order {
+		id := "rId" + string(rune('1'+i))
+		workbook += `<sheet name="` + name + `" sheetId="` + string(rune('1'+i)) + `" r:id="` + id + `"/>`
+		rels += `<Relationship Id="` + id + `" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/` + id + `.xml"/>`
+		write("xl/worksheets/"+id+".xml", `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`+sheets[name]+`</sheetData></worksheet>`)
+	}
+	write("xl/workbook.xml", workbook+`</sheets></workbook>`)
+	write("xl/_rels/workbook.xml.rels", rels+`</Relationships>`)
+	write("xl/sharedStrings.xml", `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`+shared+`</sst>`)
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestFromXLSX(t *testing.T) {
+	shared := `<si><t>Account</t></si>` +
+		`<si><t>Amount</t></si>` +
+		`<si><r><t>Q3 </t></r><r><rPr><b/></rPr><t>Report</t></r></si>` +
+		`<si><t>Rent</t></si>`
+	sheets := map[string]string{
+		"Summary": `<row r="1"><c r="A1" t="inlineStr"><is><t>Total</t></is></c></row>`,
+		"Details": `<row r="1"><c r="A1" t="s"><v>2</v></c></row>` +
+			`<row r="3"><c r="B3" t="s"><v>0</v></c><c r="C3" t="s"><v>1</v></c><c r="D3" t="inlineStr"><is><t>Paid</t></is></c><c r="E3"/></row>` +
+			`<row r="4"><c r="B4" t="s"><v>3</v></c><c r="C4"><v>1200.5</v></c><c r="D4" t="b"><v>1</v></c></row>` +
+			`<row r="5"><c r="B5" t="str"><v>Power</v></c><c r="C5"><v>0.30000000000000004</v></c><c r="D5" t="b"><v>0</v></c><c r="F5" t="inlineStr"><is><t>note</t></is></c></row>` +
+			`<row r="7"><c r="B7" t="e"><v>#N/A</v></c><c r="C7"><v>-3</v></c></row>`,
+	}
+	workbook := newTestWorkbook(t, shared, sheets, []string{"Summary", "Details"})
+
+	tests := []struct {
+		name        string
+		options     []tablr.ImportOption
+		wantColumns []string
+		wantRows    [][]string
+		wantAligns  []tablr.Alignment
+		wantErr     bool
+	}{
+		{
+			name:        "First sheet",
+			wantColumns: []string{"Total"},
+			wantRows:    [][]string{},
+			wantAligns:  []tablr.Alignment{tablr.AlignDefault},
+		},
+		{
+			name:        "Sheet by name with header detection",
+			options:     []tablr.ImportOption{tablr.WithSheetName("Details")},
+			wantColumns: []string{"Account", "Amount", "Paid", "E", "F"},
+			wantRows: [][]string{
+				{"Rent", "1200.5", "TRUE", "", ""},
+				{"Power", "0.3", "FALSE", "", "note"},
+				{"#N/A", "-3", "", "", ""},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignDefault, tablr.AlignRight, tablr.AlignDefault, tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name:        "Sheet by index with cell range",
+			options:     []tablr.ImportOption{tablr.WithSheetIndex(1), tablr.WithCellRange("$B$3:C5")},
+			wantColumns: []string{"Account", "Amount"},
+			wantRows: [][]string{
+				{"Rent", "1200.5"},
+				{"Power", "0.3"},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignDefault, tablr.AlignRight},
+		},
+		{
+			name:        "Explicit header row",
+			options:     []tablr.ImportOption{tablr.WithSheetName("Details"), tablr.WithHeaderRow(4), tablr.WithAllowedColumns("Rent", "TRUE")},
+			wantColumns: []string{"Rent", "TRUE"},
+			wantRows: [][]string{
+				{"Power", "FALSE"},
+				{"#N/A", ""},
+			},
+			wantAligns: []tablr.Alignment{tablr.AlignDefault, tablr.AlignDefault},
+		},
+		{
+			name:    "Unknown sheet name",
+			options: []tablr.ImportOption{tablr.WithSheetName("Missing")},
+			wantErr: true,
+		},
+		{
+			name:    "Sheet index out of range",
+			options: []tablr.ImportOption{tablr.WithSheetIndex(2)},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid cell range",
+			options: []tablr.ImportOption{tablr.WithCellRange("B3")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := tablr.FromXLSX(bytes.NewReader(workbook), tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromXLSX() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := table.GetColumns(); !equalSlices(got, tt.wantColumns) {
+				t.Errorf("FromXLSX() columns = %v, want %v", got, tt.wantColumns)
+			}
+			if got := table.GetRows(); !equalRows(got, tt.wantRows) {
+				t.Errorf("FromXLSX() rows = %v, want %v", got, tt.wantRows)
+			}
+			if got := table.GetAlignments(); !equalSlices(got, tt.wantAligns) {
+				t.Errorf("FromXLSX() alignments = %v, want %v", got, tt.wantAligns)
+			}
+		})
+	}
+}
+
+func TestFromXLSX_RoundTrip(t *testing.T) {
+	table := tablr.New(&bytes.Buffer{}, []string{"Name", "Age", "City", "ID"})
+	table.AddRows([][]string{
+		{"John <Doe>", "30", "New York", "12345678901234567890"},
+		{"Jane Smith", "25", " padded ", "4111111111111111111"},
+		{"Max", "0.5", "Oslo", "123456789012345"},
+	})
+
+	var buf bytes.Buffer
+	if err := table.RenderXLSX(&buf); err != nil {
+		t.Fatalf("RenderXLSX() error = %v", err)
+	}
+
+	// Numbers with more than 15 significant digits are stored as text, as
+	// they would be rounded otherwise.
+	var sheet xlsxTestSheet
+	if err := xml.Unmarshal(readZipFile(t, buf.Bytes(), "xl/worksheets/sheet1.xml"), &sheet); err != nil {
+		t.Fatalf("Unmarshal(sheet) error = %v", err)
+	}
+	var types []string
+	for _, row := range sheet.Rows[1:] {
+		types = append(types, row.Cells[3].T)
+	}
+	if want := []string{"inlineStr", "inlineStr", ""}; !equalSlices(types, want) {
+		t.Errorf("RenderXLSX() ID cell types = %q, want %q", types, want)
+	}
+
+	got, err := tablr.FromXLSX(&buf)
+	if err != nil {
+		t.Fatalf("FromXLSX() error = %v", err)
+	}
+	if !equalSlices(got.GetColumns(), table.GetColumns()) {
+		t.Errorf("FromXLSX() columns = %v, want %v", got.GetColumns(), table.GetColumns())
+	}
+	if !equalRows(got.GetRows(), table.GetRows()) {
+		t.Errorf("FromXLSX() rows = %v, want %v", got.GetRows(), table.GetRows())
+	}
+}
+
+func TestFromXLSX_NotAWorkbook(t *testing.T) {
+	if _, err := tablr.FromXLSX(bytes.NewReader([]byte("not a zip"))); err == nil {
+		t.Error("FromXLSX() error = nil, want error")
+	}
+}